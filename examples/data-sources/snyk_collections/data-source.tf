@@ -0,0 +1,3 @@
+data "snyk_collections" "all" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+}
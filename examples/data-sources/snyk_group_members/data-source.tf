@@ -0,0 +1,3 @@
+data "snyk_group_members" "all" {
+  group_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+}
@@ -0,0 +1,7 @@
+data "snyk_integrations" "example" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+}
+
+output "github_integration_id" {
+  value = data.snyk_integrations.example.integrations["github"]
+}
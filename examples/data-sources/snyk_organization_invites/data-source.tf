@@ -0,0 +1,7 @@
+data "snyk_organization_invites" "payments" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+}
+
+output "payments_pending_invites" {
+  value = [for invite in data.snyk_organization_invites.payments.invites : invite.email]
+}
@@ -0,0 +1,7 @@
+data "snyk_organization_members" "payments" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+}
+
+output "payments_admins" {
+  value = [for member in data.snyk_organization_members.payments.members : member.email if member.role_name == "Org Admin"]
+}
@@ -0,0 +1,12 @@
+data "snyk_projects" "github_npm" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  origins         = ["github"]
+  types           = ["npm"]
+
+  tags = [
+    {
+      key   = "team"
+      value = "payments"
+    },
+  ]
+}
@@ -0,0 +1,10 @@
+data "snyk_role" "collaborator" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  name            = "Org Collaborator"
+}
+
+resource "snyk_organization_membership" "alice" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  email           = "alice@example.com"
+  role_id         = data.snyk_role.collaborator.id
+}
@@ -0,0 +1,7 @@
+data "snyk_roles" "all" {
+  group_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+}
+
+output "custom_roles" {
+  value = { for role in data.snyk_roles.all.roles : role.name => role.id if role.custom }
+}
@@ -0,0 +1,10 @@
+data "snyk_self" "current" {}
+
+resource "snyk_environment" "example" {
+  name            = "created by ${data.snyk_self.current.name}"
+  kind            = "aws"
+  organization_id = data.snyk_self.current.default_organization_id
+  aws {
+    role_arn = "arn:aws:iam::XXXXXXXXXXXX:role/snyk-cloud-role-XXXXXXXX"
+  }
+}
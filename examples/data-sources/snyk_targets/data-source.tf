@@ -0,0 +1,5 @@
+data "snyk_targets" "private_github" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  source_types    = ["github"]
+  is_private      = true
+}
@@ -0,0 +1,8 @@
+locals {
+  ping = jsonencode({ webhookId = snyk_webhook.siem.id })
+}
+
+output "ping_signature" {
+  value     = provider::snyk::webhook_signature(snyk_webhook.siem.secret, local.ping)
+  sensitive = true
+}
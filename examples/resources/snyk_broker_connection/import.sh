@@ -0,0 +1,2 @@
+# Broker connections are imported by tenant id, deployment id and connection id
+terraform import snyk_broker_connection.gitlab XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
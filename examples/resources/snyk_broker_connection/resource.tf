@@ -0,0 +1,26 @@
+resource "snyk_broker_connection" "gitlab" {
+  tenant_id     = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  deployment_id = snyk_broker_deployment.onprem.id
+  name          = "gitlab"
+  type          = "gitlab"
+
+  configuration = {
+    gitlab            = "gitlab.example.com"
+    broker_client_url = "https://broker.example.com:8000"
+  }
+
+  credential_references = {
+    gitlab_token = "$${GITLAB_TOKEN}"
+  }
+}
+
+resource "snyk_broker_connection" "artifactory" {
+  tenant_id     = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  deployment_id = snyk_broker_deployment.onprem.id
+  name          = "artifactory"
+  type          = "artifactory"
+
+  configuration = {
+    artifactory_url = "artifactory.example.com/artifactory"
+  }
+}
@@ -0,0 +1,2 @@
+# Broker connection integrations are imported by tenant id, connection id, organization id and integration id
+terraform import snyk_broker_connection_integration.gitlab XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
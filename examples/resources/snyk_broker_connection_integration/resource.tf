@@ -0,0 +1,6 @@
+resource "snyk_broker_connection_integration" "gitlab" {
+  tenant_id       = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  connection_id   = snyk_broker_connection.gitlab.id
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  integration_id  = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+}
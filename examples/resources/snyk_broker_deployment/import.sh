@@ -0,0 +1,2 @@
+# Broker deployments are imported by tenant id, install id and deployment id
+terraform import snyk_broker_deployment.onprem XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
@@ -0,0 +1,9 @@
+resource "snyk_broker_deployment" "onprem" {
+  tenant_id               = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  install_id              = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  app_installed_in_org_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+
+  metadata = {
+    cluster = "onprem-eu-1"
+  }
+}
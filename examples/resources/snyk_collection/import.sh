@@ -0,0 +1,2 @@
+# Collections are imported by organization id and collection id
+terraform import snyk_collection.critical XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
@@ -0,0 +1,17 @@
+resource "snyk_collection" "critical" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  name            = "Critical services"
+  project_ids = [
+    "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX",
+    "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX",
+  ]
+}
+
+resource "snyk_collection" "payments" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  name            = "Payments"
+
+  selector = {
+    tags = [{ key = "team", value = "payments" }]
+  }
+}
@@ -0,0 +1,2 @@
+# Custom roles are imported by group id and role id
+terraform import snyk_custom_role.ci XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
@@ -0,0 +1,18 @@
+resource "snyk_custom_role" "ci" {
+  group_id    = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  name        = "CI"
+  description = "Tests and monitors projects from the CI pipelines"
+  scope       = "org"
+  permissions = [
+    "org.read",
+    "org.project.read",
+    "org.project.add",
+    "org.project.test",
+  ]
+}
+
+resource "snyk_organization_service_account" "ci" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  name            = "ci"
+  role_id         = snyk_custom_role.ci.id
+}
@@ -0,0 +1,2 @@
+# Group memberships are imported by group id and membership id
+terraform import snyk_group_membership.bob XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
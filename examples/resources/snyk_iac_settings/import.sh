@@ -0,0 +1,3 @@
+# IaC settings are imported by scope and id
+terraform import snyk_iac_settings.group group/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
+terraform import snyk_iac_settings.organization organization/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
@@ -0,0 +1,11 @@
+resource "snyk_iac_settings" "group" {
+  group_id                      = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  custom_rules_enabled          = true
+  custom_rules_oci_registry_url = "https://registry-1.docker.io/example/custom-rules"
+  custom_rules_oci_registry_tag = "latest"
+}
+
+resource "snyk_iac_settings" "organization" {
+  organization_id    = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  inherit_from_group = true
+}
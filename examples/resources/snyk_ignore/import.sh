@@ -0,0 +1,3 @@
+# Ignores are imported by organization id, project id, issue id and path. The path defaults to *, all the paths.
+terraform import snyk_ignore.lodash "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/SNYK-JS-LODASH-567746/express@4.17.1 > lodash@4.17.15"
+terraform import snyk_ignore.minimist XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/SNYK-JS-MINIMIST-559764
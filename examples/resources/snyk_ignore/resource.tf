@@ -0,0 +1,17 @@
+resource "snyk_ignore" "lodash" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  project_id      = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  issue_id        = "SNYK-JS-LODASH-567746"
+  path            = "express@4.17.1 > lodash@4.17.15"
+  reason          = "The vulnerable function is not called"
+  reason_type     = "not-vulnerable"
+}
+
+resource "snyk_ignore" "minimist" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  project_id      = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  issue_id        = "SNYK-JS-MINIMIST-559764"
+  reason          = "Waiting for the upgrade of the build tools"
+  reason_type     = "temporary-ignore"
+  expires         = "2024-12-31T00:00:00Z"
+}
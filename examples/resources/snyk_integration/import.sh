@@ -0,0 +1,2 @@
+# Integrations are imported by organization id and type
+terraform import snyk_integration.gitlab XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/gitlab
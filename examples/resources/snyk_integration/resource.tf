@@ -0,0 +1,22 @@
+variable "gitlab_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "snyk_integration" "gitlab" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  type            = "gitlab"
+  gitlab {
+    token = var.gitlab_token
+    url   = "https://gitlab.example.com"
+  }
+}
+
+resource "snyk_integration" "ecr" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  type            = "ecr"
+  ecr {
+    region   = "eu-west-1"
+    role_arn = "arn:aws:iam::XXXXXXXXXXXX:role/snyk-ecr-role"
+  }
+}
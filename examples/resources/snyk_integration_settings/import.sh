@@ -0,0 +1,2 @@
+# Integration settings are imported by organization id and integration id
+terraform import snyk_integration_settings.github XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
@@ -0,0 +1,15 @@
+resource "snyk_integration_settings" "github" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  integration_id  = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+
+  pull_request_test_enabled      = true
+  pull_request_fail_on_any_vulns = false
+  auto_dep_upgrade_enabled       = true
+  auto_dep_upgrade_limit         = 5
+
+  pull_request_assignment {
+    enabled   = true
+    type      = "manual"
+    assignees = ["octocat"]
+  }
+}
@@ -0,0 +1,5 @@
+# The settings of an organization are imported by organization id
+terraform import snyk_notification_settings.org XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
+
+# The settings of the authenticated user are imported by organization id and user
+terraform import snyk_notification_settings.me XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/user
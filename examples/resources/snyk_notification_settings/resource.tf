@@ -0,0 +1,22 @@
+resource "snyk_notification_settings" "org" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+
+  new_issues_remediations {
+    enabled        = true
+    issue_severity = "high"
+    issue_type     = "vuln"
+  }
+
+  weekly_report {
+    enabled = false
+  }
+}
+
+resource "snyk_notification_settings" "me" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  scope           = "user"
+
+  project_imported {
+    enabled = false
+  }
+}
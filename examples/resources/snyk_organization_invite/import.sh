@@ -0,0 +1,2 @@
+# Organization invites are imported by organization id and invite id
+terraform import snyk_organization_invite.bob XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
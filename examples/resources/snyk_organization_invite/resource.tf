@@ -0,0 +1,5 @@
+resource "snyk_organization_invite" "bob" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  email           = "bob@example.com"
+  role_id         = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+}
@@ -0,0 +1,2 @@
+# Organization memberships are imported by organization id and membership id
+terraform import snyk_organization_membership.alice XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
@@ -0,0 +1,6 @@
+resource "snyk_organization_membership" "alice" {
+  organization_id   = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  email             = "alice@example.com"
+  role_id           = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  invite_if_missing = true
+}
@@ -0,0 +1,2 @@
+# Organization settings are imported by organization id
+terraform import snyk_organization_settings.payments XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
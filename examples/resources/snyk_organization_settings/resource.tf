@@ -0,0 +1,16 @@
+resource "snyk_organization" "payments" {
+  name     = "payments"
+  group_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+}
+
+resource "snyk_organization_settings" "payments" {
+  organization_id        = snyk_organization.payments.id
+  request_access_enabled = false
+  snyk_code_enabled      = true
+
+  new_issues_notification {
+    enabled        = true
+    issue_severity = "high"
+    issue_type     = "all"
+  }
+}
@@ -0,0 +1,2 @@
+# Policies are imported by group id and policy id
+terraform import snyk_policy.licenses XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
@@ -0,0 +1,44 @@
+resource "snyk_policy" "downgrade_dev" {
+  group_id    = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  name        = "Downgrade development issues"
+  description = "Issues without known exploits in development projects are low severity"
+  type        = "security"
+
+  project_attributes = {
+    lifecycle = ["development", "sandbox"]
+  }
+
+  rules = [
+    {
+      name = "No known exploit"
+      conditions = {
+        exploit_maturities = ["no-known-exploit", "no-data"]
+      }
+      action = {
+        type     = "change_severity"
+        severity = "low"
+      }
+    },
+  ]
+}
+
+resource "snyk_policy" "licenses" {
+  group_id         = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  name             = "Copyleft licenses"
+  type             = "license"
+  organization_ids = ["XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"]
+
+  rules = [
+    {
+      name = "GPL"
+      conditions = {
+        license_types = ["GPL-2.0", "GPL-3.0", "AGPL-3.0"]
+      }
+      action = {
+        type         = "set_license_severity"
+        severity     = "high"
+        instructions = "Ask the legal team before using this dependency"
+      }
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Projects are imported by organization id and project id
+terraform import snyk_project.goof XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
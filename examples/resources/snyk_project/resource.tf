@@ -0,0 +1,17 @@
+resource "snyk_project" "goof" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  target_id       = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  target_file     = "package.json"
+
+  business_criticality = ["high"]
+  environment          = ["frontend", "external"]
+  project_lifecycle    = ["production"]
+  test_frequency       = "daily"
+
+  tags = [
+    {
+      key   = "team"
+      value = "payments"
+    },
+  ]
+}
@@ -0,0 +1,22 @@
+resource "snyk_project_import" "goof" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  integration_id  = snyk_integration.github.id
+
+  target {
+    owner  = "snyk"
+    name   = "goof"
+    branch = "main"
+  }
+
+  files           = ["package.json"]
+  exclusion_globs = "fixtures, tests"
+}
+
+resource "snyk_project_import" "nginx" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  integration_id  = snyk_integration.docker_hub.id
+
+  target {
+    name = "library/nginx:latest"
+  }
+}
@@ -0,0 +1,13 @@
+resource "snyk_project_tags" "payments" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+
+  selector = {
+    origins    = ["github"]
+    name_regex = "^acme/payments-"
+  }
+
+  tags = [
+    { key = "team", value = "payments" },
+    { key = "owner", value = "terraform" },
+  ]
+}
@@ -0,0 +1,2 @@
+# Snyk Code settings are imported by organization id
+terraform import snyk_sast_settings.payments XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
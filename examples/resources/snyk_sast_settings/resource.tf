@@ -0,0 +1,9 @@
+resource "snyk_organization" "payments" {
+  name     = "payments"
+  group_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+}
+
+resource "snyk_sast_settings" "payments" {
+  organization_id = snyk_organization.payments.id
+  sast_enabled    = true
+}
@@ -0,0 +1,2 @@
+# Targets are imported by organization id and target id
+terraform import snyk_target.goof XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
@@ -0,0 +1,5 @@
+resource "snyk_target" "goof" {
+  organization_id   = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  display_name      = "snyk/goof"
+  delete_on_destroy = true
+}
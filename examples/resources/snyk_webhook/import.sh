@@ -0,0 +1,2 @@
+# Webhooks are imported by organization id and webhook id
+terraform import snyk_webhook.siem XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX/XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX
@@ -0,0 +1,10 @@
+resource "snyk_webhook" "siem" {
+  organization_id = "XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXXXXX"
+  url             = "https://siem.example.com/snyk"
+  ping_on_create  = true
+}
+
+output "siem_webhook_secret" {
+  value     = snyk_webhook.siem.secret
+  sensitive = true
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+// Connection is the connection of a deployment to a system, e.g. a GitLab
+// instance. Its Configuration holds the fields required by its Type, such as
+// the URL of the system, and references to the credentials of the
+// deployment, such as ${GITLAB_TOKEN}.
+type Connection struct {
+	ID            string
+	DeploymentID  string
+	Name          string
+	Type          string
+	Configuration map[string]string
+}
+
+type connectionConfiguration struct {
+	Type     string            `json:"type"`
+	Required map[string]string `json:"required"`
+}
+
+type connectionData struct {
+	ID         string `json:"id,omitempty"`
+	Type       string `json:"type"`
+	Attributes struct {
+		DeploymentID  string                  `json:"deployment_id"`
+		Name          string                  `json:"name"`
+		Configuration connectionConfiguration `json:"configuration"`
+	} `json:"attributes"`
+}
+
+type connectionRequest struct {
+	Data connectionData `json:"data"`
+}
+
+type connectionResponse struct {
+	Data connectionData `json:"data"`
+}
+
+func (d *connectionData) connection() *Connection {
+	configuration := d.Attributes.Configuration.Required
+	if configuration == nil {
+		configuration = map[string]string{}
+	}
+
+	return &Connection{
+		ID:            d.ID,
+		DeploymentID:  d.Attributes.DeploymentID,
+		Name:          d.Attributes.Name,
+		Type:          d.Attributes.Configuration.Type,
+		Configuration: configuration,
+	}
+}
+
+func (c *Client) connectionsURL(tenantID string, deploymentID string) string {
+	return fmt.Sprintf("%s/rest/tenants/%s/brokers/deployments/%s/connections", c.URL, tenantID, deploymentID)
+}
+
+// GetConnection returns the connection id of the deployment deploymentID of
+// the tenant tenantID, or nil if there is no such connection.
+func (c *Client) GetConnection(ctx context.Context, tenantID string, deploymentID string, id string) (*Connection, error) {
+	var resp connectionResponse
+	err := c.GetREST(ctx, c.connectionsURL(tenantID, deploymentID)+"/"+id, &resp)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data.connection(), nil
+}
+
+func (c *Client) sendConnection(ctx context.Context, method string, url string, expectedStatus int, connection *Connection) (*Connection, error) {
+	var request connectionRequest
+	request.Data.ID = connection.ID
+	request.Data.Type = "broker_connection"
+	request.Data.Attributes.DeploymentID = connection.DeploymentID
+	request.Data.Attributes.Name = connection.Name
+	request.Data.Attributes.Configuration = connectionConfiguration{
+		Type:     connection.Type,
+		Required: connection.Configuration,
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+
+	var resp connectionResponse
+	if err := c.Do(ctx, method, c.WithVersion(url), "application/vnd.api+json", &body, expectedStatus, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data.connection(), nil
+}
+
+// CreateConnection creates a connection of the deployment
+// connection.DeploymentID of the tenant tenantID. The ID of connection is
+// ignored.
+func (c *Client) CreateConnection(ctx context.Context, tenantID string, connection Connection) (*Connection, error) {
+	connection.ID = ""
+	return c.sendConnection(ctx, http.MethodPost, c.connectionsURL(tenantID, connection.DeploymentID), http.StatusCreated, &connection)
+}
+
+// UpdateConnection changes the name and configuration of the connection
+// connection.ID.
+func (c *Client) UpdateConnection(ctx context.Context, tenantID string, connection Connection) (*Connection, error) {
+	return c.sendConnection(ctx, http.MethodPatch, c.connectionsURL(tenantID, connection.DeploymentID)+"/"+connection.ID, http.StatusOK, &connection)
+}
+
+// DeleteConnection deletes the connection id of the deployment deploymentID
+// of the tenant tenantID.
+func (c *Client) DeleteConnection(ctx context.Context, tenantID string, deploymentID string, id string) error {
+	url := c.WithVersion(c.connectionsURL(tenantID, deploymentID) + "/" + id)
+
+	err := c.Do(ctx, http.MethodDelete, url, "application/vnd.api+json", nil, http.StatusNoContent, nil)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		// The connection, or its deployment, is already gone.
+		return nil
+	}
+	return err
+}
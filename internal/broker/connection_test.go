@@ -0,0 +1,81 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCreateConnection(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/tenants/tenant/brokers/deployments/deployment/connections" || r.URL.Query().Get("version") != VERSION {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"connection","type":"broker_connection","attributes":{"deployment_id":"deployment","name":"gitlab","configuration":{"type":"gitlab","required":{"gitlab":"gitlab.example.com","gitlab_token":"${GITLAB_TOKEN}"}}}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{URL: server.URL, Token: "token", Version: VERSION})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configuration := map[string]string{"gitlab": "gitlab.example.com", "gitlab_token": "${GITLAB_TOKEN}"}
+	connection, err := client.CreateConnection(context.Background(), "tenant", Connection{
+		ID:            "ignored",
+		DeploymentID:  "deployment",
+		Name:          "gitlab",
+		Type:          "gitlab",
+		Configuration: configuration,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedConnection := &Connection{ID: "connection", DeploymentID: "deployment", Name: "gitlab", Type: "gitlab", Configuration: configuration}
+	if !reflect.DeepEqual(connection, expectedConnection) {
+		t.Errorf("expected connection %v, got %v", expectedConnection, connection)
+	}
+
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "broker_connection",
+			"attributes": map[string]interface{}{
+				"deployment_id": "deployment",
+				"name":          "gitlab",
+				"configuration": map[string]interface{}{
+					"type": "gitlab",
+					"required": map[string]interface{}{
+						"gitlab":       "gitlab.example.com",
+						"gitlab_token": "${GITLAB_TOKEN}",
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(request, expected) {
+		t.Errorf("expected request %v, got %v", expected, request)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+// Deployment is a Universal Broker deployment, i.e. a broker client running
+// on premises, of a Snyk App install of a tenant.
+type Deployment struct {
+	ID                  string
+	InstallID           string
+	AppInstalledInOrgID string
+	Metadata            map[string]string
+}
+
+// DeploymentAttributes are the attributes of a deployment set on creation
+// and update.
+type DeploymentAttributes struct {
+	InstallID           string            `json:"install_id"`
+	AppInstalledInOrgID string            `json:"broker_app_installed_in_org_id"`
+	Metadata            map[string]string `json:"metadata"`
+}
+
+type deploymentData struct {
+	ID         string               `json:"id,omitempty"`
+	Type       string               `json:"type"`
+	Attributes DeploymentAttributes `json:"attributes"`
+}
+
+type deploymentRequest struct {
+	Data deploymentData `json:"data"`
+}
+
+type deploymentResponse struct {
+	Data deploymentData `json:"data"`
+}
+
+func (d *deploymentData) deployment() *Deployment {
+	metadata := d.Attributes.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	return &Deployment{
+		ID:                  d.ID,
+		InstallID:           d.Attributes.InstallID,
+		AppInstalledInOrgID: d.Attributes.AppInstalledInOrgID,
+		Metadata:            metadata,
+	}
+}
+
+// ListDeployments returns the deployments of the install installID of the
+// tenant tenantID.
+func (c *Client) ListDeployments(ctx context.Context, tenantID string, installID string) ([]*Deployment, error) {
+	data, err := snyk_http.GetAllPages[deploymentData](ctx, c.APIClient, fmt.Sprintf("%s/rest/tenants/%s/brokers/installs/%s/deployments", c.URL, tenantID, installID))
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := []*Deployment{}
+	for i := range data {
+		deployments = append(deployments, data[i].deployment())
+	}
+
+	return deployments, nil
+}
+
+// GetDeployment returns the deployment id of the install installID of the
+// tenant tenantID, or nil if there is no such deployment. The API has no
+// endpoint for a single deployment, so they are all listed.
+func (c *Client) GetDeployment(ctx context.Context, tenantID string, installID string, id string) (*Deployment, error) {
+	deployments, err := c.ListDeployments(ctx, tenantID, installID)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range deployments {
+		if d.ID == id {
+			return d, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) sendDeployment(ctx context.Context, method string, url string, expectedStatus int, id string, attributes DeploymentAttributes) (*Deployment, error) {
+	request := deploymentRequest{Data: deploymentData{ID: id, Type: "broker_deployment", Attributes: attributes}}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+
+	var resp deploymentResponse
+	if err := c.Do(ctx, method, c.WithVersion(url), "application/vnd.api+json", &body, expectedStatus, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data.deployment(), nil
+}
+
+// CreateDeployment creates a deployment of the install attributes.InstallID
+// of the tenant tenantID.
+func (c *Client) CreateDeployment(ctx context.Context, tenantID string, attributes DeploymentAttributes) (*Deployment, error) {
+	url := fmt.Sprintf("%s/rest/tenants/%s/brokers/installs/%s/deployments", c.URL, tenantID, attributes.InstallID)
+	return c.sendDeployment(ctx, http.MethodPost, url, http.StatusCreated, "", attributes)
+}
+
+// UpdateDeployment changes the metadata of the deployment id.
+func (c *Client) UpdateDeployment(ctx context.Context, tenantID string, id string, attributes DeploymentAttributes) (*Deployment, error) {
+	url := fmt.Sprintf("%s/rest/tenants/%s/brokers/installs/%s/deployments/%s", c.URL, tenantID, attributes.InstallID, id)
+	return c.sendDeployment(ctx, http.MethodPatch, url, http.StatusOK, id, attributes)
+}
+
+// DeleteDeployment deletes the deployment id of the install installID of the
+// tenant tenantID.
+func (c *Client) DeleteDeployment(ctx context.Context, tenantID string, installID string, id string) error {
+	url := c.WithVersion(fmt.Sprintf("%s/rest/tenants/%s/brokers/installs/%s/deployments/%s", c.URL, tenantID, installID, id))
+
+	err := c.Do(ctx, http.MethodDelete, url, "application/vnd.api+json", nil, http.StatusNoContent, nil)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		// The deployment is already gone.
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package broker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+// Integration is the integration of an organization using a connection.
+type Integration struct {
+	ID    string
+	OrgID string
+	Type  string
+}
+
+type integrationData struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		OrgID           string `json:"org_id"`
+		IntegrationType string `json:"integration_type"`
+	} `json:"attributes"`
+}
+
+type integrationRequest struct {
+	Data struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"data"`
+}
+
+// ListIntegrations returns the integrations using the connection
+// connectionID of the tenant tenantID.
+func (c *Client) ListIntegrations(ctx context.Context, tenantID string, connectionID string) ([]*Integration, error) {
+	data, err := snyk_http.GetAllPages[integrationData](ctx, c.APIClient, fmt.Sprintf("%s/rest/tenants/%s/brokers/connections/%s/integrations", c.URL, tenantID, connectionID))
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		return []*Integration{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	integrations := []*Integration{}
+	for _, d := range data {
+		integrations = append(integrations, &Integration{
+			ID:    d.ID,
+			OrgID: d.Attributes.OrgID,
+			Type:  d.Attributes.IntegrationType,
+		})
+	}
+
+	return integrations, nil
+}
+
+// BindIntegration makes the integration integrationID of the organization
+// orgID use the connection connectionID of the tenant tenantID.
+func (c *Client) BindIntegration(ctx context.Context, tenantID string, connectionID string, orgID string, integrationID string) error {
+	var request integrationRequest
+	request.Data.ID = integrationID
+	request.Data.Type = "broker_integration"
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/tenants/%s/brokers/connections/%s/orgs/%s/integration", c.URL, tenantID, connectionID, orgID))
+
+	return c.Do(ctx, http.MethodPost, url, "application/vnd.api+json", &body, http.StatusCreated, nil)
+}
+
+// UnbindIntegration stops the integration integrationID of the organization
+// orgID from using the connection connectionID of the tenant tenantID.
+func (c *Client) UnbindIntegration(ctx context.Context, tenantID string, connectionID string, orgID string, integrationID string) error {
+	url := c.WithVersion(fmt.Sprintf("%s/rest/tenants/%s/brokers/connections/%s/orgs/%s/integrations/%s", c.URL, tenantID, connectionID, orgID, integrationID))
+
+	err := c.Do(ctx, http.MethodDelete, url, "application/vnd.api+json", nil, http.StatusNoContent, nil)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		// The binding, or its connection, is already gone.
+		return nil
+	}
+	return err
+}
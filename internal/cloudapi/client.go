@@ -25,6 +25,10 @@ import (
 
 const VERSION = "2022-04-13~experimental"
 
+// SUBSYSTEM is the name of the tflog subsystem the requests of this client
+// are logged in.
+const SUBSYSTEM = "cloudapi"
+
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
@@ -44,26 +48,25 @@ type Client struct {
 	version       string
 }
 
-func NewClient(url string, token string) (*Client, error) {
-	httpClient, err := snyk_http.NewClient(
-		snyk_http.WithExtraCertificates(os.Getenv("NODE_EXTRA_CA_CERTS")),
-	)
-	if err != nil {
-		return nil, err
+// NewClient creates a client for the given configuration. When no HTTPClient
+// is configured, one trusting the certificates in NODE_EXTRA_CA_CERTS and
+// logging in SUBSYSTEM is used, and the Version defaults to VERSION.
+func NewClient(config ClientConfig) (*Client, error) {
+	if config.HTTPClient == nil {
+		httpClient, err := snyk_http.NewClient(
+			snyk_http.WithExtraCertificates(os.Getenv("NODE_EXTRA_CA_CERTS")),
+		)
+		if err != nil {
+			return nil, err
+		}
+		config.HTTPClient = snyk_http.WithLogging(httpClient, SUBSYSTEM)
 	}
 
-	cloudapiClient, err := newClient(ClientConfig{
-		HTTPClient: httpClient,
-		URL:        url,
-		Token:      token,
-		Version:    VERSION,
-	})
-
-	if err != nil {
-		return nil, err
-	} else {
-		return cloudapiClient, nil
+	if config.Version == "" {
+		config.Version = VERSION
 	}
+
+	return newClient(config)
 }
 
 func newClient(config ClientConfig) (*Client, error) {
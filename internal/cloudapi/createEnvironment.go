@@ -149,6 +149,4 @@ func (c *Client) CreateEnvironment(ctx context.Context, orgID string, request *E
 	} else {
 		return nil, fmt.Errorf("invalid status code: %v", res.StatusCode)
 	}
-
-	return nil, err
 }
@@ -0,0 +1,62 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type collectionRequest struct {
+	Data struct {
+		Type       string `json:"type"`
+		Attributes struct {
+			Name string `json:"name"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+func newCollectionRequest(name string) (*bytes.Buffer, error) {
+	var request collectionRequest
+	request.Data.Type = "resource"
+	request.Data.Attributes.Name = name
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+	return &body, nil
+}
+
+// CreateCollection creates an empty collection named name in the
+// organization orgID.
+func (c *Client) CreateCollection(ctx context.Context, orgID string, name string) (*Collection, error) {
+	body, err := newCollectionRequest(name)
+	if err != nil {
+		return nil, err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/orgs/%s/collections", c.URL, orgID))
+
+	var resp collectionResponse
+	if err := c.Do(ctx, http.MethodPost, url, "application/vnd.api+json", body, http.StatusCreated, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data.collection(), nil
+}
@@ -0,0 +1,36 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+// DeleteCollection deletes the collection id of the organization orgID. Its
+// projects are not deleted.
+func (c *Client) DeleteCollection(ctx context.Context, orgID string, id string) error {
+	url := c.WithVersion(fmt.Sprintf("%s/rest/orgs/%s/collections/%s", c.URL, orgID, id))
+
+	err := c.Do(ctx, http.MethodDelete, url, "application/vnd.api+json", nil, http.StatusNoContent, nil)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		// The collection, or its organization, is already gone.
+		return nil
+	}
+	return err
+}
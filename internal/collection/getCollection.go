@@ -0,0 +1,82 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+// Collection is a named group of projects of an organization. Generated
+// collections are maintained by Snyk and cannot be changed.
+type Collection struct {
+	ID          string
+	Name        string
+	IsGenerated bool
+}
+
+type collectionData struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Name        string `json:"name"`
+		IsGenerated bool   `json:"is_generated"`
+	} `json:"attributes"`
+}
+
+type collectionResponse struct {
+	Data collectionData `json:"data"`
+}
+
+func (d *collectionData) collection() *Collection {
+	return &Collection{
+		ID:          d.ID,
+		Name:        d.Attributes.Name,
+		IsGenerated: d.Attributes.IsGenerated,
+	}
+}
+
+// GetCollection returns the collection id of the organization orgID, or nil
+// if there is no such collection.
+func (c *Client) GetCollection(ctx context.Context, orgID string, id string) (*Collection, error) {
+	var resp collectionResponse
+	err := c.GetREST(ctx, fmt.Sprintf("%s/rest/orgs/%s/collections/%s", c.URL, orgID, id), &resp)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data.collection(), nil
+}
+
+// ListCollections returns the collections of the organization orgID.
+func (c *Client) ListCollections(ctx context.Context, orgID string) ([]*Collection, error) {
+	data, err := snyk_http.GetAllPages[collectionData](ctx, c.APIClient, fmt.Sprintf("%s/rest/orgs/%s/collections?limit=100", c.URL, orgID))
+	if err != nil {
+		return nil, err
+	}
+
+	collections := []*Collection{}
+	for i := range data {
+		collections = append(collections, data[i].collection())
+	}
+
+	return collections, nil
+}
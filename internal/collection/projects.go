@@ -0,0 +1,82 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+type projectRelationship struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type projectsRequest struct {
+	Data []projectRelationship `json:"data"`
+}
+
+// ListProjects returns the IDs of the projects of the collection id of the
+// organization orgID.
+func (c *Client) ListProjects(ctx context.Context, orgID string, id string) ([]string, error) {
+	data, err := snyk_http.GetAllPages[projectRelationship](ctx, c.APIClient, fmt.Sprintf("%s/rest/orgs/%s/collections/%s/relationships/projects?limit=100", c.URL, orgID, id))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, p := range data {
+		ids = append(ids, p.ID)
+	}
+
+	return ids, nil
+}
+
+// AddProjects adds the projects projectIDs to the collection id of the
+// organization orgID.
+func (c *Client) AddProjects(ctx context.Context, orgID string, id string, projectIDs []string) error {
+	return c.changeProjects(ctx, http.MethodPost, orgID, id, projectIDs)
+}
+
+// RemoveProjects removes the projects projectIDs from the collection id of
+// the organization orgID.
+func (c *Client) RemoveProjects(ctx context.Context, orgID string, id string, projectIDs []string) error {
+	return c.changeProjects(ctx, http.MethodDelete, orgID, id, projectIDs)
+}
+
+func (c *Client) changeProjects(ctx context.Context, method string, orgID string, id string, projectIDs []string) error {
+	if len(projectIDs) == 0 {
+		return nil
+	}
+
+	request := projectsRequest{Data: []projectRelationship{}}
+	for _, projectID := range projectIDs {
+		request.Data = append(request.Data, projectRelationship{ID: projectID, Type: "project"})
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/orgs/%s/collections/%s/relationships/projects", c.URL, orgID, id))
+
+	return c.Do(ctx, method, url, "application/vnd.api+json", &body, http.StatusNoContent, nil)
+}
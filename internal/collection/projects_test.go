@@ -0,0 +1,73 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestRemoveProjects(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/rest/orgs/org/collections/collection/relationships/projects" || r.URL.Query().Get("version") != VERSION {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{URL: server.URL, Token: "token", Version: VERSION})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.RemoveProjects(context.Background(), "org", "collection", []string{"a", "b"}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"data": []interface{}{
+			map[string]interface{}{"id": "a", "type": "project"},
+			map[string]interface{}{"id": "b", "type": "project"},
+		},
+	}
+	if !reflect.DeepEqual(request, expected) {
+		t.Errorf("expected request %v, got %v", expected, request)
+	}
+}
+
+func TestRemoveNoProjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{URL: server.URL, Token: "token", Version: VERSION})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.RemoveProjects(context.Background(), "org", "collection", nil); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// UpdateCollection renames the collection id of the organization orgID.
+func (c *Client) UpdateCollection(ctx context.Context, orgID string, id string, name string) (*Collection, error) {
+	body, err := newCollectionRequest(name)
+	if err != nil {
+		return nil, err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/orgs/%s/collections/%s", c.URL, orgID, id))
+
+	var resp collectionResponse
+	if err := c.Do(ctx, http.MethodPatch, url, "application/vnd.api+json", body, http.StatusOK, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data.collection(), nil
+}
@@ -0,0 +1,225 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// HTTPClient sends HTTP requests, as *http.Client does.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// APIClientConfig is the configuration of an APIClient.
+type APIClientConfig struct {
+	HTTPClient  HTTPClient
+	URL         string
+	Token       string
+	BearerToken string
+	Version     string
+}
+
+// APIClient sends authenticated requests to the Snyk API. The clients of
+// each part of the API embed it.
+type APIClient struct {
+	HTTPClient    HTTPClient
+	URL           string
+	Authorization string
+	Version       string
+}
+
+// NewAPIClient creates a client for the given configuration. When no
+// HTTPClient is configured, one trusting the certificates in
+// NODE_EXTRA_CA_CERTS and logging in subsystem is used, and the Version
+// defaults to version. The URL and the token default to the SNYK_API and
+// SNYK_TOKEN environment variables.
+func NewAPIClient(config APIClientConfig, subsystem string, version string) (*APIClient, error) {
+	if config.HTTPClient == nil {
+		httpClient, err := NewClient(
+			WithExtraCertificates(os.Getenv("NODE_EXTRA_CA_CERTS")),
+		)
+		if err != nil {
+			return nil, err
+		}
+		config.HTTPClient = WithLogging(httpClient, subsystem)
+	}
+
+	if config.Version == "" {
+		config.Version = version
+	}
+
+	if config.URL == "" {
+		if env, ok := os.LookupEnv("SNYK_API"); ok {
+			config.URL = env
+		} else {
+			return nil, fmt.Errorf("no URL provided")
+		}
+	}
+
+	if config.Token == "" && config.BearerToken == "" {
+		if env, ok := os.LookupEnv("SNYK_TOKEN"); ok {
+			config.Token = env
+		} else {
+			return nil, fmt.Errorf("no token provided")
+		}
+	}
+
+	if config.Version == "" {
+		return nil, fmt.Errorf("no version provided")
+	}
+
+	parsedURL, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %v", err)
+	}
+
+	sanitizedURL := url.URL{
+		Scheme: parsedURL.Scheme,
+		Host:   parsedURL.Host,
+	}
+
+	var authzHeader string
+	if config.BearerToken != "" {
+		authzHeader = fmt.Sprintf("Bearer %s", config.BearerToken)
+	} else {
+		authzHeader = fmt.Sprintf("token %s", config.Token)
+	}
+
+	client := APIClient{
+		HTTPClient:    config.HTTPClient,
+		URL:           sanitizedURL.String(),
+		Authorization: authzHeader,
+		Version:       config.Version,
+	}
+
+	return &client, nil
+}
+
+// Get sends a GET request to the v1 API at url.
+func (c *APIClient) Get(ctx context.Context, url string, result interface{}) error {
+	return c.Do(ctx, http.MethodGet, url, "application/json", nil, http.StatusOK, result)
+}
+
+// GetREST sends a GET request to the REST API at url, adding the version of
+// the client to the query unless it is already set, as in pagination links.
+func (c *APIClient) GetREST(ctx context.Context, url string, result interface{}) error {
+	return c.Do(ctx, http.MethodGet, c.WithVersion(url), "application/vnd.api+json", nil, http.StatusOK, result)
+}
+
+type page[T any] struct {
+	Data  []T `json:"data"`
+	Links struct {
+		Next string `json:"next,omitempty"`
+	} `json:"links"`
+}
+
+// GetAllPages returns the data of all the pages of a REST collection,
+// starting at url and following the next links.
+func GetAllPages[T any](ctx context.Context, c *APIClient, url string) ([]T, error) {
+	data := []T{}
+
+	next := url
+	for next != "" {
+		var resp page[T]
+		if err := c.GetREST(ctx, next, &resp); err != nil {
+			return nil, err
+		}
+
+		data = append(data, resp.Data...)
+
+		next = ""
+		if resp.Links.Next != "" {
+			next = c.RESTURL(resp.Links.Next)
+		}
+	}
+
+	return data, nil
+}
+
+// WithVersion adds the version of the client to the query of url, unless it
+// is already set.
+func (c *APIClient) WithVersion(url string) string {
+	if strings.Contains(url, "version=") {
+		return url
+	}
+	if strings.Contains(url, "?") {
+		return url + "&version=" + c.Version
+	}
+	return url + "?version=" + c.Version
+}
+
+// RESTURL returns the absolute URL of a link returned by the REST API, which
+// may or may not include the /rest prefix.
+func (c *APIClient) RESTURL(link string) string {
+	if strings.HasPrefix(link, "http://") || strings.HasPrefix(link, "https://") {
+		return link
+	}
+	if !strings.HasPrefix(link, "/rest/") {
+		link = "/rest" + link
+	}
+	return c.URL + link
+}
+
+// Do sends a request and decodes its response into result, unless result is
+// nil. It returns a StatusError if the response status is not expectedStatus.
+func (c *APIClient) Do(ctx context.Context, method string, url string, contentType string, body io.Reader, expectedStatus int, result interface{}) error {
+	_, err := c.DoWithHeader(ctx, method, url, contentType, body, expectedStatus, result)
+	return err
+}
+
+// DoWithHeader is like Do, and also returns the headers of the response.
+func (c *APIClient) DoWithHeader(ctx context.Context, method string, url string, contentType string, body io.Reader, expectedStatus int, result interface{}) (header http.Header, e error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", c.Authorization)
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := res.Body.Close(); err != nil && e == nil {
+			e = err
+		}
+	}()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != expectedStatus {
+		return nil, &StatusError{StatusCode: res.StatusCode, Body: string(resBody)}
+	}
+
+	if result == nil {
+		return res.Header, nil
+	}
+
+	return res.Header, json.Unmarshal(resBody, result)
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetAllPages(t *testing.T) {
+	var versions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		versions = append(versions, r.URL.Query().Get("version"))
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = w.Write([]byte(`{"data":["a","b"],"links":{"next":"/orgs/org/items?version=2024-02-28&starting_after=b"}}`))
+		} else {
+			_, _ = w.Write([]byte(`{"data":["c"],"links":{}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewAPIClient(APIClientConfig{HTTPClient: server.Client(), URL: server.URL, Token: "token"}, "test", "2024-02-28")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := GetAllPages[string](context.Background(), client, server.URL+"/rest/orgs/org/items")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 3 || data[0] != "a" || data[2] != "c" {
+		t.Fatalf("unexpected data %v", data)
+	}
+	if len(versions) != 2 || versions[0] != "2024-02-28" || versions[1] != "2024-02-28" {
+		t.Fatalf("unexpected versions %v", versions)
+	}
+}
+
+func TestDoStatusError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer bearer" {
+			t.Errorf("unexpected Authorization header %q", r.Header.Get("Authorization"))
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := NewAPIClient(APIClientConfig{HTTPClient: server.Client(), URL: server.URL, BearerToken: "bearer"}, "test", "2024-02-28")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.Do(context.Background(), http.MethodGet, server.URL+"/v1/org/org", "application/json", nil, http.StatusOK, nil)
+	if !HasStatusCode(err, http.StatusNotFound) {
+		t.Fatalf("expected a not found StatusError, got %v", err)
+	}
+}
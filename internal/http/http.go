@@ -19,13 +19,21 @@ import (
 	"crypto/x509"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 
 	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/net/http/httpproxy"
 )
 
 type config struct {
-	certificates  []string
+	certificates       []string
+	certificatePEMs    []string
+	clientCertFile     string
+	clientKeyFile      string
+	proxyURL           string
+	noProxy            string
+	insecureSkipVerify bool
 }
 
 // Option is a configuration option for the HTTP client.
@@ -33,11 +41,53 @@ type Option func(c *config)
 
 // WithExtraCertificates adds more certificates to the pool of certificates
 // trusted by this client. path is the path to a PEM file containing one or more
-// certificates. If path is empty or the file at path does not exist, no
-// certificates are added to the pool.
+// certificates. If path is empty, no certificates are added to the pool.
 func WithExtraCertificates(path string) Option {
 	return func(c *config) {
-		c.certificates = append(c.certificates, path)
+		if path != "" {
+			c.certificates = append(c.certificates, path)
+		}
+	}
+}
+
+// WithExtraCertificatesPEM adds more certificates to the pool of certificates
+// trusted by this client. pem contains one or more PEM encoded certificates.
+// If pem is empty, no certificates are added to the pool.
+func WithExtraCertificatesPEM(pem string) Option {
+	return func(c *config) {
+		if pem != "" {
+			c.certificatePEMs = append(c.certificatePEMs, pem)
+		}
+	}
+}
+
+// WithClientCertificate makes the client authenticate with the certificate and
+// private key stored as PEM in certFile and keyFile. If both paths are empty,
+// no client certificate is used.
+func WithClientCertificate(certFile, keyFile string) Option {
+	return func(c *config) {
+		c.clientCertFile = certFile
+		c.clientKeyFile = keyFile
+	}
+}
+
+// WithProxy routes the requests of the client through the proxy at proxyURL,
+// except for the hosts matched by noProxy, which uses the syntax of the
+// NO_PROXY environment variable. Empty values fall back to the HTTPS_PROXY,
+// HTTP_PROXY and NO_PROXY environment variables.
+func WithProxy(proxyURL, noProxy string) Option {
+	return func(c *config) {
+		c.proxyURL = proxyURL
+		c.noProxy = noProxy
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the certificate chain
+// and host name presented by the server. It should only be used against test
+// installations.
+func WithInsecureSkipVerify(insecureSkipVerify bool) Option {
+	return func(c *config) {
+		c.insecureSkipVerify = insecureSkipVerify
 	}
 }
 
@@ -60,18 +110,68 @@ func NewClient(options ...Option) (*http.Client, error) {
 		return nil, fmt.Errorf("load certificates: %v", err)
 	}
 
+	for _, pem := range c.certificatePEMs {
+		if ok := pool.AppendCertsFromPEM([]byte(pem)); !ok {
+			return nil, fmt.Errorf("load certificates: no certificates found in PEM data")
+		}
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		RootCAs:            pool,
+		InsecureSkipVerify: c.insecureSkipVerify,
+	}
+
+	if c.clientCertFile != "" || c.clientKeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(c.clientCertFile, c.clientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	proxy, err := proxyFunc(c.proxyURL, c.noProxy)
+	if err != nil {
+		return nil, err
+	}
+
 	client := cleanhttp.DefaultClient()
 
 	if transport, ok := client.Transport.(*http.Transport); ok {
-		transport.TLSClientConfig = &tls.Config{
-			MinVersion:         tls.VersionTLS12,
-			RootCAs:            pool,
+		transport.TLSClientConfig = tlsConfig
+		if proxy != nil {
+			transport.Proxy = proxy
 		}
 	}
 
 	return client, nil
 }
 
+// proxyFunc returns the proxy selection function for the given settings, or
+// nil if the defaults taken from the environment should be kept.
+func proxyFunc(proxyURL, noProxy string) (func(*http.Request) (*url.URL, error), error) {
+	if proxyURL == "" && noProxy == "" {
+		return nil, nil
+	}
+
+	proxyConfig := httpproxy.FromEnvironment()
+	if proxyURL != "" {
+		if _, err := url.Parse(proxyURL); err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %v", err)
+		}
+		proxyConfig.HTTPProxy = proxyURL
+		proxyConfig.HTTPSProxy = proxyURL
+	}
+	if noProxy != "" {
+		proxyConfig.NoProxy = noProxy
+	}
+
+	proxyForURL := proxyConfig.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyForURL(req.URL)
+	}, nil
+}
+
 func loadCertificates(pool *x509.CertPool, certificates []string) error {
 	for _, certificate := range certificates {
 		if err := loadCertificate(pool, certificate); err != nil {
@@ -84,15 +184,12 @@ func loadCertificates(pool *x509.CertPool, certificates []string) error {
 
 func loadCertificate(pool *x509.CertPool, certPath string) error {
 	certData, err := os.ReadFile(certPath)
-	if os.IsNotExist(err) {
-		return nil
-	}
 	if err != nil {
 		return fmt.Errorf("read certificates: %v", err)
 	}
 
 	if ok := pool.AppendCertsFromPEM(certData); !ok {
-		return fmt.Errorf("no certificates found from NODE_EXTRA_CA_CERTS")
+		return fmt.Errorf("no certificates found in %s", certPath)
 	}
 
 	return nil
@@ -0,0 +1,81 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewClientMissingCertificateFile(t *testing.T) {
+	_, err := NewClient(WithExtraCertificates(filepath.Join(t.TempDir(), "missing.pem")))
+	if err == nil {
+		t.Fatal("expected an error for a missing certificate file")
+	}
+}
+
+func TestNewClientEmptyCertificatePath(t *testing.T) {
+	if _, err := NewClient(WithExtraCertificates("")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewClientInvalidCertificatePEM(t *testing.T) {
+	if _, err := NewClient(WithExtraCertificatesPEM("not a certificate")); err == nil {
+		t.Fatal("expected an error for invalid PEM data")
+	}
+}
+
+func TestNewClientMissingClientCertificate(t *testing.T) {
+	dir := t.TempDir()
+	_, err := NewClient(WithClientCertificate(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")))
+	if err == nil {
+		t.Fatal("expected an error for a missing client certificate")
+	}
+}
+
+func TestNewClientProxy(t *testing.T) {
+	client, err := NewClient(WithProxy("http://proxy.example.com:3128", "internal.example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := client.Transport.(*http.Transport)
+
+	tests := []struct {
+		url   string
+		proxy string
+	}{
+		{url: "https://api.snyk.io/rest/self", proxy: "http://proxy.example.com:3128"},
+		{url: "https://internal.example.com/rest/self", proxy: ""},
+	}
+	for _, test := range tests {
+		req, err := http.NewRequest(http.MethodGet, test.url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proxy, err := transport.Proxy(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := ""
+		if proxy != nil {
+			got = proxy.String()
+		}
+		if got != test.proxy {
+			t.Errorf("proxy for %s: expected %q, got %q", test.url, test.proxy, got)
+		}
+	}
+}
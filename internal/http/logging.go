@@ -0,0 +1,212 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redacted = "***"
+
+// redactedFields are the keys of the JSON fields whose values are never
+// logged.
+var redactedFields = map[string]bool{
+	"api_key":       true,
+	"client_secret": true,
+	"password":      true,
+	"secret":        true,
+	"token":         true,
+}
+
+// redactedHeaders are the HTTP headers whose values are never logged.
+var redactedHeaders = map[string]bool{
+	"Authorization": true,
+}
+
+// WithLogging returns a copy of client which logs its requests and responses
+// through tflog, in the given subsystem. Summaries are logged at debug level
+// and headers and JSON bodies at trace level, with credentials masked. The
+// logs are enabled with TF_LOG_PROVIDER, or TF_LOG_PROVIDER_SNYK_<SUBSYSTEM>
+// for a single subsystem.
+func WithLogging(client *http.Client, subsystem string) *http.Client {
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	loggingClient := *client
+	loggingClient.Transport = &loggingTransport{transport: transport, subsystem: subsystem}
+
+	return &loggingClient
+}
+
+type loggingTransport struct {
+	transport http.RoundTripper
+	subsystem string
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), t.subsystem)
+	if authorization := req.Header.Get("Authorization"); authorization != "" {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, t.subsystem, authorization)
+	}
+
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    req.URL.String(),
+	}
+
+	tflog.SubsystemDebug(ctx, t.subsystem, "Sending HTTP request", fields)
+	tflog.SubsystemTrace(ctx, t.subsystem, "HTTP request details", map[string]interface{}{
+		"http_method":          req.Method,
+		"http_url":             req.URL.String(),
+		"http_request_headers": redactHeaders(req.Header),
+		"http_request_body":    requestBody(req),
+	})
+
+	start := time.Now()
+	res, err := t.transport.RoundTrip(req)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, t.subsystem, "HTTP request failed", fields)
+		return res, err
+	}
+
+	fields["http_status_code"] = res.StatusCode
+	fields["snyk_request_id"] = res.Header.Get("snyk-request-id")
+	tflog.SubsystemDebug(ctx, t.subsystem, "Received HTTP response", fields)
+
+	body, err := responseBody(res)
+	if err != nil {
+		return nil, err
+	}
+	tflog.SubsystemTrace(ctx, t.subsystem, "HTTP response details", map[string]interface{}{
+		"http_method":           req.Method,
+		"http_url":              req.URL.String(),
+		"http_status_code":      res.StatusCode,
+		"http_response_headers": redactHeaders(res.Header),
+		"http_response_body":    body,
+	})
+
+	return res, nil
+}
+
+// requestBody returns the body of req for logging, without consuming it.
+func requestBody(req *http.Request) string {
+	if req.Body == nil || req.GetBody == nil || !isJSON(req.Header.Get("Content-Type")) {
+		return ""
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+
+	return redactJSON(data)
+}
+
+// responseBody returns the body of res for logging, and replaces it with a
+// copy so that it can still be read by the caller.
+func responseBody(res *http.Response) (string, error) {
+	if res.Body == nil || !isJSON(res.Header.Get("Content-Type")) {
+		return "", nil
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if closeErr := res.Body.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("read response body: %v", err)
+	}
+	res.Body = io.NopCloser(bytes.NewReader(data))
+
+	return redactJSON(data), nil
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	result := make(map[string]string, len(header))
+	for key := range header {
+		if redactedHeaders[http.CanonicalHeaderKey(key)] {
+			result[key] = redacted
+		} else {
+			result[key] = header.Get(key)
+		}
+	}
+	return result
+}
+
+// redactJSON masks the values of the redacted fields anywhere in data. Bodies
+// which cannot be parsed are not logged, since they cannot be redacted.
+func redactJSON(data []byte) string {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return ""
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Sprintf("[%d bytes of invalid JSON]", len(data))
+	}
+
+	result, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return fmt.Sprintf("[%d bytes]", len(data))
+	}
+
+	return string(result)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if redactedFields[key] {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRedactJSON(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected string
+	}{
+		{
+			body:     `{"data":{"attributes":{"name":"ci","api_key":"secret-key"}}}`,
+			expected: `{"data":{"attributes":{"api_key":"***","name":"ci"}}}`,
+		},
+		{
+			body:     `[{"client_secret":"secret"},{"id":"1"}]`,
+			expected: `[{"client_secret":"***"},{"id":"1"}]`,
+		},
+		{
+			body:     `{"url":"https://example.com/snyk","secret":"webhook-secret"}`,
+			expected: `{"secret":"***","url":"https://example.com/snyk"}`,
+		},
+		{
+			body:     `not json "api_key": "secret-key"`,
+			expected: `[32 bytes of invalid JSON]`,
+		},
+		{
+			body:     ``,
+			expected: ``,
+		},
+	}
+	for _, test := range tests {
+		if got := redactJSON([]byte(test.body)); got != test.expected {
+			t.Errorf("redactJSON(%s): expected %s, got %s", test.body, test.expected, got)
+		}
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "token secret")
+	header.Set("Content-Type", "application/json")
+
+	redactedHeader := redactHeaders(header)
+	if redactedHeader["Authorization"] != redacted {
+		t.Errorf("expected the Authorization header to be redacted, got %q", redactedHeader["Authorization"])
+	}
+	if redactedHeader["Content-Type"] != "application/json" {
+		t.Errorf("expected the Content-Type header to be kept, got %q", redactedHeader["Content-Type"])
+	}
+}
+
+func TestWithLoggingPreservesBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Header().Set("snyk-request-id", "request-id")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client := WithLogging(server.Client(), "test")
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewBufferString(`{"api_key":"secret-key"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != `{"api_key":"secret-key"}` {
+		t.Fatalf("unexpected response body %s", body)
+	}
+}
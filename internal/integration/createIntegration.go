@@ -0,0 +1,85 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const TYPE_GITHUB = "github"
+const TYPE_GITHUB_ENTERPRISE = "github-enterprise"
+const TYPE_GITLAB = "gitlab"
+const TYPE_BITBUCKET_CLOUD = "bitbucket-cloud"
+const TYPE_BITBUCKET_SERVER = "bitbucket-server"
+const TYPE_AZURE_REPOS = "azure-repos"
+const TYPE_ECR = "ecr"
+const TYPE_ACR = "acr"
+const TYPE_GCR = "gcr"
+const TYPE_DOCKER_HUB = "docker-hub"
+
+// Types are all the integration types supported by the provider.
+var Types = []string{
+	TYPE_GITHUB,
+	TYPE_GITHUB_ENTERPRISE,
+	TYPE_GITLAB,
+	TYPE_BITBUCKET_CLOUD,
+	TYPE_BITBUCKET_SERVER,
+	TYPE_AZURE_REPOS,
+	TYPE_ECR,
+	TYPE_ACR,
+	TYPE_GCR,
+	TYPE_DOCKER_HUB,
+}
+
+type IntegrationRequest struct {
+	Type        string       `json:"type"`
+	Credentials *Credentials `json:"credentials,omitempty"`
+}
+
+// Credentials of an integration. Which fields are used depends on the type of
+// the integration.
+type Credentials struct {
+	Token        string `json:"token,omitempty"`
+	Username     string `json:"username,omitempty"`
+	Password     string `json:"password,omitempty"`
+	URL          string `json:"url,omitempty"`
+	Region       string `json:"region,omitempty"`
+	RoleArn      string `json:"roleArn,omitempty"`
+	RegistryBase string `json:"registryBase,omitempty"`
+}
+
+type IntegrationResponse struct {
+	ID string `json:"id"`
+}
+
+func (c *Client) CreateIntegration(ctx context.Context, orgID string, request *IntegrationRequest) (*IntegrationResponse, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/v1/org/%s/integrations", c.URL, orgID)
+
+	var resp IntegrationResponse
+	if err := c.Do(ctx, http.MethodPost, url, "application/json", &body, http.StatusOK, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+// DeleteIntegration removes the credentials of an integration, which is as
+// close to deleting it as the API allows.
+func (c *Client) DeleteIntegration(ctx context.Context, orgID, integrationID string) error {
+	url := fmt.Sprintf("%s/v1/org/%s/integrations/%s/authentication", c.URL, orgID, integrationID)
+
+	err := c.Do(ctx, http.MethodDelete, url, "application/json", nil, http.StatusOK, nil)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		// The integration, or its organization, is already gone.
+		return nil
+	}
+
+	return err
+}
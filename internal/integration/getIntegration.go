@@ -0,0 +1,43 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetIntegration returns the integration of the given type in an
+// organization. It returns a StatusError with http.StatusNotFound if the
+// organization has no such integration.
+func (c *Client) GetIntegration(ctx context.Context, orgID string, integrationType string) (ir *IntegrationResponse, e error) {
+	var resp IntegrationResponse
+	if err := c.Get(ctx, fmt.Sprintf("%s/v1/org/%s/integrations/%s", c.URL, orgID, integrationType), &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ListIntegrations returns the ids of the integrations of an organization,
+// keyed by integration type.
+func (c *Client) ListIntegrations(ctx context.Context, orgID string) (map[string]string, error) {
+	integrations := map[string]string{}
+	if err := c.Get(ctx, fmt.Sprintf("%s/v1/org/%s/integrations", c.URL, orgID), &integrations); err != nil {
+		return nil, err
+	}
+
+	return integrations, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Settings of an integration. Nil fields are left unchanged by
+// UpdateIntegrationSettings.
+type Settings struct {
+	PullRequestTestEnabled             *bool                  `json:"pullRequestTestEnabled,omitempty"`
+	PullRequestFailOnAnyVulns          *bool                  `json:"pullRequestFailOnAnyVulns,omitempty"`
+	PullRequestFailOnlyForHighSeverity *bool                  `json:"pullRequestFailOnlyForHighSeverity,omitempty"`
+	PullRequestAssignment              *PullRequestAssignment `json:"pullRequestAssignment,omitempty"`
+	AutoDepUpgradeEnabled              *bool                  `json:"autoDepUpgradeEnabled,omitempty"`
+	AutoDepUpgradeLimit                *int64                 `json:"autoDepUpgradeLimit,omitempty"`
+	AutoDepUpgradeMinAge               *int64                 `json:"autoDepUpgradeMinAge,omitempty"`
+	AutoDepUpgradeIgnoredDependencies  *[]string              `json:"autoDepUpgradeIgnoredDependencies,omitempty"`
+	AutoRemediationPrs                 *AutoRemediationPrs    `json:"autoRemediationPrs,omitempty"`
+}
+
+type PullRequestAssignment struct {
+	Enabled   *bool     `json:"enabled,omitempty"`
+	Type      *string   `json:"type,omitempty"`
+	Assignees *[]string `json:"assignees,omitempty"`
+}
+
+type AutoRemediationPrs struct {
+	FreshPrsEnabled     *bool   `json:"freshPrsEnabled,omitempty"`
+	BacklogPrsEnabled   *bool   `json:"backlogPrsEnabled,omitempty"`
+	BacklogPrStrategy   *string `json:"backlogPrStrategy,omitempty"`
+	UsePatchRemediation *bool   `json:"usePatchRemediation,omitempty"`
+}
+
+func (c *Client) GetIntegrationSettings(ctx context.Context, orgID string, integrationID string) (*Settings, error) {
+	var settings Settings
+	if err := c.Get(ctx, fmt.Sprintf("%s/v1/org/%s/integrations/%s/settings", c.URL, orgID, integrationID), &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// UpdateIntegrationSettings changes the non-nil settings of an integration,
+// and returns all its settings.
+func (c *Client) UpdateIntegrationSettings(ctx context.Context, orgID string, integrationID string, request *Settings) (*Settings, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/v1/org/%s/integrations/%s/settings", c.URL, orgID, integrationID)
+
+	var settings Settings
+	if err := c.Do(ctx, http.MethodPut, url, "application/json", &body, http.StatusOK, &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
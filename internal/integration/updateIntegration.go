@@ -0,0 +1,35 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// UpdateIntegration replaces the credentials of an integration.
+func (c *Client) UpdateIntegration(ctx context.Context, orgID string, integrationID string, request *IntegrationRequest) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v1/org/%s/integrations/%s", c.URL, orgID, integrationID)
+
+	return c.Do(ctx, http.MethodPut, url, "application/json", &body, http.StatusOK, nil)
+}
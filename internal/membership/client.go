@@ -0,0 +1,43 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membership
+
+import (
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+const VERSION = "2024-02-28"
+
+// SUBSYSTEM is the name of the tflog subsystem the requests of this client
+// are logged in.
+const SUBSYSTEM = "membership"
+
+type ClientConfig = snyk_http.APIClientConfig
+
+type Client struct {
+	*snyk_http.APIClient
+}
+
+// NewClient creates a client for the given configuration. When no HTTPClient
+// is configured, one trusting the certificates in NODE_EXTRA_CA_CERTS and
+// logging in SUBSYSTEM is used, and the Version defaults to VERSION.
+func NewClient(config ClientConfig) (*Client, error) {
+	apiClient, err := snyk_http.NewAPIClient(config, SUBSYSTEM, VERSION)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{apiClient}, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membership
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type relationshipData struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type relationship struct {
+	Data relationshipData `json:"data"`
+}
+
+type membershipRequest struct {
+	Data struct {
+		ID            string                  `json:"id,omitempty"`
+		Type          string                  `json:"type"`
+		Relationships map[string]relationship `json:"relationships"`
+	} `json:"data"`
+}
+
+type membershipResponse struct {
+	Data membershipData `json:"data"`
+}
+
+// membershipType returns the JSON:API type of the memberships of scope, and
+// the type of the resource they belong to.
+func membershipType(scope string) (string, string) {
+	if scope == SCOPE_GROUP {
+		return "group_membership", "group"
+	}
+	return "org_membership", "org"
+}
+
+// CreateMembership gives a role to a user in the organization or group id,
+// depending on scope, and returns the id of the membership.
+func (c *Client) CreateMembership(ctx context.Context, scope string, id string, userID string, roleID string) (string, error) {
+	memberType, scopeType := membershipType(scope)
+
+	var request membershipRequest
+	request.Data.Type = memberType
+	request.Data.Relationships = map[string]relationship{
+		scopeType: {Data: relationshipData{ID: id, Type: scopeType}},
+		"user":    {Data: relationshipData{ID: userID, Type: "user"}},
+		"role":    {Data: relationshipData{ID: roleID, Type: scopeType + "_role"}},
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return "", err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/%s/%s/memberships", c.URL, scope, id))
+
+	var resp membershipResponse
+	if err := c.Do(ctx, http.MethodPost, url, "application/vnd.api+json", &body, http.StatusCreated, &resp); err != nil {
+		return "", err
+	}
+
+	return resp.Data.ID, nil
+}
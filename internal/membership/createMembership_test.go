@@ -0,0 +1,66 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membership
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCreateMembership(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/groups/group/memberships" || r.URL.Query().Get("version") != VERSION {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"membership","type":"group_membership"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{URL: server.URL, Token: "token", Version: VERSION})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := client.CreateMembership(context.Background(), SCOPE_GROUP, "group", "user", "role")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "membership" {
+		t.Errorf("expected membership id membership, got %s", id)
+	}
+
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "group_membership",
+			"relationships": map[string]interface{}{
+				"group": map[string]interface{}{"data": map[string]interface{}{"id": "group", "type": "group"}},
+				"user":  map[string]interface{}{"data": map[string]interface{}{"id": "user", "type": "user"}},
+				"role":  map[string]interface{}{"data": map[string]interface{}{"id": "role", "type": "group_role"}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(request, expected) {
+		t.Errorf("expected request %v, got %v", expected, request)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membership
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+// DeleteMembership removes a user from the organization or group scopeID.
+func (c *Client) DeleteMembership(ctx context.Context, scope string, scopeID string, id string) error {
+	url := c.WithVersion(fmt.Sprintf("%s/rest/%s/%s/memberships/%s", c.URL, scope, scopeID, id))
+
+	err := c.Do(ctx, http.MethodDelete, url, "application/vnd.api+json", nil, http.StatusNoContent, nil)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		// The membership, or its organization or group, is already gone.
+		return nil
+	}
+	return err
+}
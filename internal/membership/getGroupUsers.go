@@ -0,0 +1,49 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membership
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GroupUser is a user of one of the organizations of a group.
+type GroupUser struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// FindGroupUser returns the user of one of the organizations of a group
+// with the given email, or nil if there is no such user.
+func (c *Client) FindGroupUser(ctx context.Context, groupID string, email string) (*GroupUser, error) {
+	url := fmt.Sprintf("%s/v1/group/%s/members", c.URL, groupID)
+
+	var users []GroupUser
+	if err := c.Do(ctx, http.MethodGet, url, "application/json", nil, http.StatusOK, &users); err != nil {
+		return nil, err
+	}
+
+	for i := range users {
+		if strings.EqualFold(users[i].Email, email) {
+			return &users[i], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membership
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+const (
+	SCOPE_ORG   = "orgs"
+	SCOPE_GROUP = "groups"
+)
+
+// Membership is the role of a user in an organization or a group.
+type Membership struct {
+	ID       string
+	UserID   string
+	Name     string
+	Email    string
+	Username string
+	RoleID   string
+	RoleName string
+}
+
+type membershipData struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	Relationships struct {
+		User struct {
+			Data struct {
+				ID         string `json:"id"`
+				Attributes struct {
+					Name     string `json:"name"`
+					Email    string `json:"email"`
+					Username string `json:"username"`
+				} `json:"attributes"`
+			} `json:"data"`
+		} `json:"user"`
+		Role struct {
+			Data struct {
+				ID         string `json:"id"`
+				Attributes struct {
+					Name string `json:"name"`
+				} `json:"attributes"`
+			} `json:"data"`
+		} `json:"role"`
+	} `json:"relationships"`
+}
+
+func (d *membershipData) membership() *Membership {
+	return &Membership{
+		ID:       d.ID,
+		UserID:   d.Relationships.User.Data.ID,
+		Name:     d.Relationships.User.Data.Attributes.Name,
+		Email:    d.Relationships.User.Data.Attributes.Email,
+		Username: d.Relationships.User.Data.Attributes.Username,
+		RoleID:   d.Relationships.Role.Data.ID,
+		RoleName: d.Relationships.Role.Data.Attributes.Name,
+	}
+}
+
+// ListMemberships returns the memberships of the organization or group id,
+// depending on scope, SCOPE_ORG or SCOPE_GROUP. A non-empty email only
+// returns the membership of the user with that email.
+func (c *Client) ListMemberships(ctx context.Context, scope string, id string, email string) ([]*Membership, error) {
+	query := url.Values{}
+	query.Set("limit", "100")
+	if email != "" {
+		query.Set("email", email)
+	}
+
+	data, err := snyk_http.GetAllPages[membershipData](ctx, c.APIClient, fmt.Sprintf("%s/rest/%s/%s/memberships?%s", c.URL, scope, id, query.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := []*Membership{}
+	for i := range data {
+		memberships = append(memberships, data[i].membership())
+	}
+
+	return memberships, nil
+}
+
+// GetMembership returns the membership id of the organization or group
+// scopeID, or nil if there is no such membership.
+func (c *Client) GetMembership(ctx context.Context, scope string, scopeID string, id string) (*Membership, error) {
+	memberships, err := c.ListMemberships(ctx, scope, scopeID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, membership := range memberships {
+		if membership.ID == id {
+			return membership, nil
+		}
+	}
+
+	return nil, nil
+}
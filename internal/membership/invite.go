@@ -0,0 +1,126 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membership
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+type inviteRequest struct {
+	Data struct {
+		Type       string `json:"type"`
+		Attributes struct {
+			Email string `json:"email"`
+			Role  string `json:"role,omitempty"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+type inviteResponse struct {
+	Data struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+// Invite is a pending invitation of an email to join an organization.
+type Invite struct {
+	ID     string
+	Email  string
+	RoleID string
+}
+
+type inviteData struct {
+	ID         string `json:"id"`
+	Attributes struct {
+		Email    string `json:"email"`
+		Role     string `json:"role"`
+		IsActive bool   `json:"is_active"`
+	} `json:"attributes"`
+}
+
+// ListInvites returns the pending invites of an organization.
+func (c *Client) ListInvites(ctx context.Context, orgID string) ([]*Invite, error) {
+	data, err := snyk_http.GetAllPages[inviteData](ctx, c.APIClient, fmt.Sprintf("%s/rest/orgs/%s/invites?limit=100", c.URL, orgID))
+	if err != nil {
+		return nil, err
+	}
+
+	invites := []*Invite{}
+	for _, invite := range data {
+		if invite.Attributes.IsActive {
+			invites = append(invites, &Invite{ID: invite.ID, Email: invite.Attributes.Email, RoleID: invite.Attributes.Role})
+		}
+	}
+
+	return invites, nil
+}
+
+// GetInvite returns a pending invite of an organization, or nil if it was
+// accepted or cancelled.
+func (c *Client) GetInvite(ctx context.Context, orgID string, id string) (*Invite, error) {
+	invites, err := c.ListInvites(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, invite := range invites {
+		if invite.ID == id {
+			return invite, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateInvite invites an email to join an organization with a role, and
+// returns the id of the invite.
+func (c *Client) CreateInvite(ctx context.Context, orgID string, email string, roleID string) (string, error) {
+	var request inviteRequest
+	request.Data.Type = "org_invitation"
+	request.Data.Attributes.Email = email
+	request.Data.Attributes.Role = roleID
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return "", err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/orgs/%s/invites", c.URL, orgID))
+
+	var resp inviteResponse
+	if err := c.Do(ctx, http.MethodPost, url, "application/vnd.api+json", &body, http.StatusCreated, &resp); err != nil {
+		return "", err
+	}
+
+	return resp.Data.ID, nil
+}
+
+// DeleteInvite cancels a pending invite.
+func (c *Client) DeleteInvite(ctx context.Context, orgID string, id string) error {
+	url := c.WithVersion(fmt.Sprintf("%s/rest/orgs/%s/invites/%s", c.URL, orgID, id))
+
+	err := c.Do(ctx, http.MethodDelete, url, "application/vnd.api+json", nil, http.StatusNoContent, nil)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		// The invite was accepted, or already cancelled.
+		return nil
+	}
+	return err
+}
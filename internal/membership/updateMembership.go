@@ -0,0 +1,45 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package membership
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// UpdateMembership changes the role of a membership of the organization or
+// group scopeID.
+func (c *Client) UpdateMembership(ctx context.Context, scope string, scopeID string, id string, roleID string) error {
+	memberType, scopeType := membershipType(scope)
+
+	var request membershipRequest
+	request.Data.ID = id
+	request.Data.Type = memberType
+	request.Data.Relationships = map[string]relationship{
+		"role": {Data: relationshipData{ID: roleID, Type: scopeType + "_role"}},
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/%s/%s/memberships/%s", c.URL, scope, scopeID, id))
+
+	return c.Do(ctx, http.MethodPatch, url, "application/vnd.api+json", &body, http.StatusNoContent, nil)
+}
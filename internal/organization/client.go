@@ -15,108 +15,29 @@
 package organization
 
 import (
-	"fmt"
-	"net/http"
-	"net/url"
-	"os"
-
 	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
 )
 
 const VERSION = "2023-09-20"
 
-type HTTPClient interface {
-	Do(req *http.Request) (*http.Response, error)
-}
+// SUBSYSTEM is the name of the tflog subsystem the requests of this client
+// are logged in.
+const SUBSYSTEM = "organization"
 
-type ClientConfig struct {
-	HTTPClient  HTTPClient
-	URL         string
-	Token       string
-	BearerToken string
-	Version     string
-}
+type ClientConfig = snyk_http.APIClientConfig
 
 type Client struct {
-	httpClient    HTTPClient
-	url           string
-	authorization string
-	version       string
+	*snyk_http.APIClient
 }
 
-func NewClient(url string, token string) (*Client, error) {
-
-	httpClient, err := snyk_http.NewClient(
-		snyk_http.WithExtraCertificates(os.Getenv("NODE_EXTRA_CA_CERTS")),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := newClient(ClientConfig{
-		HTTPClient: httpClient,
-		URL:        url,
-		Token:      token,
-		Version:    VERSION,
-	})
-
+// NewClient creates a client for the given configuration. When no HTTPClient
+// is configured, one trusting the certificates in NODE_EXTRA_CA_CERTS and
+// logging in SUBSYSTEM is used, and the Version defaults to VERSION.
+func NewClient(config ClientConfig) (*Client, error) {
+	apiClient, err := snyk_http.NewAPIClient(config, SUBSYSTEM, VERSION)
 	if err != nil {
 		return nil, err
-	} else {
-		return client, nil
-	}
-}
-
-func newClient(config ClientConfig) (*Client, error) {
-	httpClient := config.HTTPClient
-
-	if httpClient == nil {
-		httpClient = http.DefaultClient
-	}
-
-	if config.URL == "" {
-		if env, ok := os.LookupEnv("SNYK_API"); ok {
-			config.URL = env
-		} else {
-			return nil, fmt.Errorf("no URL provided")
-		}
-	}
-
-	if config.Token == "" && config.BearerToken == "" {
-		if env, ok := os.LookupEnv("SNYK_TOKEN"); ok {
-			config.Token = env
-		} else {
-			return nil, fmt.Errorf("no token provided")
-		}
-	}
-
-	if config.Version == "" {
-		return nil, fmt.Errorf("no version provided")
-	}
-
-	parsedURL, err := url.Parse(config.URL)
-	if err != nil {
-		return nil, fmt.Errorf("invalid URL: %v", err)
-	}
-
-	sanitizedURL := url.URL{
-		Scheme: parsedURL.Scheme,
-		Host:   parsedURL.Host,
-	}
-
-	var authzHeader string
-	if config.BearerToken != "" {
-		authzHeader = fmt.Sprintf("Bearer %s", config.BearerToken)
-	} else {
-		authzHeader = fmt.Sprintf("token %s", config.Token)
-	}
-
-	client := Client{
-		httpClient:    httpClient,
-		url:           sanitizedURL.String(),
-		authorization: authzHeader,
-		version:       config.Version,
 	}
 
-	return &client, nil
+	return &Client{apiClient}, nil
 }
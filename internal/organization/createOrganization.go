@@ -52,7 +52,7 @@ func (c *Client) CreateOrganization(ctx context.Context, request *OrganizationRe
 		return nil, err
 	}
 
-	url := fmt.Sprintf("%s/v1/org", c.url)
+	url := fmt.Sprintf("%s/v1/org", c.URL)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &body)
 	if err != nil {
@@ -60,13 +60,13 @@ func (c *Client) CreateOrganization(ctx context.Context, request *OrganizationRe
 	}
 
 	query := req.URL.Query()
-	query.Set("version", c.version)
+	query.Set("version", c.Version)
 	req.URL.RawQuery = query.Encode()
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", c.authorization)
+	req.Header.Set("Authorization", c.Authorization)
 
-	res, err := c.httpClient.Do(req)
+	res, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
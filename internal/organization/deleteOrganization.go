@@ -21,7 +21,7 @@ import (
 )
 
 func (c *Client) DeleteOrganization(ctx context.Context, orgID string) (e error) {
-	url := fmt.Sprintf("%s/v1/org/%s", c.url, orgID)
+	url := fmt.Sprintf("%s/v1/org/%s", c.URL, orgID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	if err != nil {
@@ -32,9 +32,9 @@ func (c *Client) DeleteOrganization(ctx context.Context, orgID string) (e error)
 	req.URL.RawQuery = query.Encode()
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", c.authorization)
+	req.Header.Set("Authorization", c.Authorization)
 
-	res, err := c.httpClient.Do(req)
+	res, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return err
 	}
@@ -49,7 +49,7 @@ type Organization struct {
 
 func (c *Client) GetOrganization(ctx context.Context, organizationID string) (org *Organization, e error) {
 
-	url := fmt.Sprintf("%s/rest/orgs/%s", c.url, organizationID)
+	url := fmt.Sprintf("%s/rest/orgs/%s", c.URL, organizationID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -57,13 +57,13 @@ func (c *Client) GetOrganization(ctx context.Context, organizationID string) (or
 	}
 
 	query := req.URL.Query()
-	query.Set("version", c.version)
+	query.Set("version", c.Version)
 	req.URL.RawQuery = query.Encode()
 
 	req.Header.Set("Content-Type", "application/vnd.api+json")
-	req.Header.Set("Authorization", c.authorization)
+	req.Header.Set("Authorization", c.Authorization)
 
-	res, err := c.httpClient.Do(req)
+	res, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
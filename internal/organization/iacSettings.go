@@ -0,0 +1,115 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	IAC_SCOPE_ORG   = "orgs"
+	IAC_SCOPE_GROUP = "groups"
+)
+
+// IacSettings are the Infrastructure as Code settings of an organization or
+// a group.
+type IacSettings struct {
+	CustomRules IacCustomRules `json:"custom_rules"`
+}
+
+type IacCustomRules struct {
+	IsEnabled         bool   `json:"is_enabled"`
+	OciRegistryURL    string `json:"oci_registry_url,omitempty"`
+	OciRegistryTag    string `json:"oci_registry_tag,omitempty"`
+	InheritFromParent string `json:"inherit_from_parent,omitempty"`
+}
+
+// IacSettingsUpdate holds the settings to change. Nil fields are left
+// unchanged. InheritFromParent is only supported by organizations, and
+// false stops the inheritance of the settings of the group.
+type IacSettingsUpdate struct {
+	IsEnabled         *bool
+	OciRegistryURL    *string
+	OciRegistryTag    *string
+	InheritFromParent *bool
+}
+
+type iacSettingsResponse struct {
+	Data struct {
+		Attributes IacSettings `json:"attributes"`
+	} `json:"data"`
+}
+
+// GetIacSettings returns the settings of the organization or group id,
+// depending on scope, IAC_SCOPE_ORG or IAC_SCOPE_GROUP.
+func (c *Client) GetIacSettings(ctx context.Context, scope string, id string) (*IacSettings, error) {
+	url := c.WithVersion(fmt.Sprintf("%s/rest/%s/%s/settings/iac", c.URL, scope, id))
+
+	var resp iacSettingsResponse
+	if err := c.Do(ctx, http.MethodGet, url, "application/vnd.api+json", nil, http.StatusOK, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Data.Attributes, nil
+}
+
+// UpdateIacSettings changes the settings of the organization or group id,
+// and returns the resulting settings.
+func (c *Client) UpdateIacSettings(ctx context.Context, scope string, id string, update *IacSettingsUpdate) (*IacSettings, error) {
+	// A map is used as inherit_from_parent is reset by sending null.
+	customRules := map[string]interface{}{}
+	if update.IsEnabled != nil {
+		customRules["is_enabled"] = *update.IsEnabled
+	}
+	if update.OciRegistryURL != nil {
+		customRules["oci_registry_url"] = *update.OciRegistryURL
+	}
+	if update.OciRegistryTag != nil {
+		customRules["oci_registry_tag"] = *update.OciRegistryTag
+	}
+	if update.InheritFromParent != nil {
+		customRules["inherit_from_parent"] = nil
+		if *update.InheritFromParent {
+			customRules["inherit_from_parent"] = "group"
+		}
+	}
+
+	request := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "iac_settings",
+			"attributes": map[string]interface{}{
+				"custom_rules": customRules,
+			},
+		},
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/%s/%s/settings/iac", c.URL, scope, id))
+
+	var resp iacSettingsResponse
+	if err := c.Do(ctx, http.MethodPatch, url, "application/vnd.api+json", &body, http.StatusOK, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Data.Attributes, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// NotificationSettings are the email notification settings of an
+// organization, or of a user in an organization. Nil fields are left
+// unchanged by UpdateNotificationSettings and
+// UpdateUserNotificationSettings.
+type NotificationSettings struct {
+	NewIssuesRemediations *IssueNotificationSetting `json:"new-issues-remediations,omitempty"`
+	ProjectImported       *NotificationSetting      `json:"project-imported,omitempty"`
+	TestLimit             *NotificationSetting      `json:"test-limit,omitempty"`
+	WeeklyReport          *NotificationSetting      `json:"weekly-report,omitempty"`
+}
+
+// IssueNotificationSetting is the setting of the notifications about new
+// issues and remediations. Inherited is only returned for the settings of a
+// user, which inherit those of the organization until they are changed.
+type IssueNotificationSetting struct {
+	Enabled       bool   `json:"enabled"`
+	IssueSeverity string `json:"issueSeverity,omitempty"`
+	IssueType     string `json:"issueType,omitempty"`
+	Inherited     bool   `json:"inherited,omitempty"`
+}
+
+// NotificationSetting is the setting of the other notifications.
+type NotificationSetting struct {
+	Enabled   bool `json:"enabled"`
+	Inherited bool `json:"inherited,omitempty"`
+}
+
+func (c *Client) GetNotificationSettings(ctx context.Context, orgID string) (*NotificationSettings, error) {
+	return c.getNotificationSettings(ctx, fmt.Sprintf("%s/v1/org/%s/notification-settings", c.URL, orgID))
+}
+
+// GetUserNotificationSettings returns the notification settings of the
+// authenticated user in the organization orgID.
+func (c *Client) GetUserNotificationSettings(ctx context.Context, orgID string) (*NotificationSettings, error) {
+	return c.getNotificationSettings(ctx, fmt.Sprintf("%s/v1/user/me/notification-settings/org/%s", c.URL, orgID))
+}
+
+func (c *Client) getNotificationSettings(ctx context.Context, url string) (*NotificationSettings, error) {
+	var settings NotificationSettings
+	if err := c.Do(ctx, http.MethodGet, url, "application/json", nil, http.StatusOK, &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// UpdateNotificationSettings changes the non-nil notification settings of
+// an organization, and returns all its notification settings.
+func (c *Client) UpdateNotificationSettings(ctx context.Context, orgID string, request *NotificationSettings) (*NotificationSettings, error) {
+	return c.updateNotificationSettings(ctx, fmt.Sprintf("%s/v1/org/%s/notification-settings", c.URL, orgID), request)
+}
+
+// UpdateUserNotificationSettings changes the non-nil notification settings
+// of the authenticated user in the organization orgID, and returns all their
+// notification settings.
+func (c *Client) UpdateUserNotificationSettings(ctx context.Context, orgID string, request *NotificationSettings) (*NotificationSettings, error) {
+	return c.updateNotificationSettings(ctx, fmt.Sprintf("%s/v1/user/me/notification-settings/org/%s", c.URL, orgID), request)
+}
+
+func (c *Client) updateNotificationSettings(ctx context.Context, url string, request *NotificationSettings) (*NotificationSettings, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+
+	var settings NotificationSettings
+	if err := c.Do(ctx, http.MethodPut, url, "application/json", &body, http.StatusOK, &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
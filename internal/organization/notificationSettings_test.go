@@ -0,0 +1,66 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateUserNotificationSettings(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/v1/user/me/notification-settings/org/org" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Error(err)
+		}
+		_, _ = w.Write([]byte(`{"weekly-report":{"enabled":false,"inherited":false},"test-limit":{"enabled":true,"inherited":true}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{URL: server.URL, Token: "token", Version: VERSION})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	settings, err := client.UpdateUserNotificationSettings(context.Background(), "org", &NotificationSettings{
+		WeeklyReport: &NotificationSetting{Enabled: false},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSettings := &NotificationSettings{
+		WeeklyReport: &NotificationSetting{Enabled: false},
+		TestLimit:    &NotificationSetting{Enabled: true, Inherited: true},
+	}
+	if !reflect.DeepEqual(settings, expectedSettings) {
+		t.Errorf("expected settings %v, got %v", expectedSettings, settings)
+	}
+
+	// The settings which are not changed are not sent.
+	expected := map[string]interface{}{
+		"weekly-report": map[string]interface{}{"enabled": false},
+	}
+	if !reflect.DeepEqual(request, expected) {
+		t.Errorf("expected request %v, got %v", expected, request)
+	}
+}
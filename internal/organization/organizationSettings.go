@@ -0,0 +1,63 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Settings are the general settings of an organization.
+type Settings struct {
+	RequestAccess *RequestAccessSettings `json:"requestAccess,omitempty"`
+}
+
+// RequestAccessSettings control whether users outside an organization may
+// request to join it.
+type RequestAccessSettings struct {
+	Enabled bool `json:"enabled"`
+}
+
+func (c *Client) GetOrganizationSettings(ctx context.Context, orgID string) (*Settings, error) {
+	url := fmt.Sprintf("%s/v1/org/%s/settings", c.URL, orgID)
+
+	var settings Settings
+	if err := c.Do(ctx, http.MethodGet, url, "application/json", nil, http.StatusOK, &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// UpdateOrganizationSettings changes the non-nil settings of an
+// organization, and returns all its settings.
+func (c *Client) UpdateOrganizationSettings(ctx context.Context, orgID string, request *Settings) (*Settings, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/v1/org/%s/settings", c.URL, orgID)
+
+	var settings Settings
+	if err := c.Do(ctx, http.MethodPut, url, "application/json", &body, http.StatusOK, &settings); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
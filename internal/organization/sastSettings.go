@@ -0,0 +1,80 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package organization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SastSettings are the Snyk Code settings of an organization.
+type SastSettings struct {
+	SastEnabled                    bool `json:"sast_enabled"`
+	SastPullRequestAnalysisEnabled bool `json:"sast_pull_request_analysis_enabled"`
+	AutofixEnabled                 bool `json:"autofix_enabled"`
+}
+
+type sastSettingsResponse struct {
+	Data struct {
+		Attributes SastSettings `json:"attributes"`
+	} `json:"data"`
+}
+
+type sastSettingsRequest struct {
+	Data struct {
+		ID         string `json:"id"`
+		Type       string `json:"type"`
+		Attributes struct {
+			SastEnabled bool `json:"sast_enabled"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+func (c *Client) GetSastSettings(ctx context.Context, orgID string) (*SastSettings, error) {
+	url := c.WithVersion(fmt.Sprintf("%s/rest/orgs/%s/settings/sast", c.URL, orgID))
+
+	var resp sastSettingsResponse
+	if err := c.Do(ctx, http.MethodGet, url, "application/vnd.api+json", nil, http.StatusOK, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Data.Attributes, nil
+}
+
+// UpdateSastSettings enables or disables Snyk Code for an organization, and
+// returns the resulting settings. The other settings are read-only.
+func (c *Client) UpdateSastSettings(ctx context.Context, orgID string, sastEnabled bool) (*SastSettings, error) {
+	var request sastSettingsRequest
+	request.Data.ID = orgID
+	request.Data.Type = "sast_settings"
+	request.Data.Attributes.SastEnabled = sastEnabled
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/orgs/%s/settings/sast", c.URL, orgID))
+
+	var resp sastSettingsResponse
+	if err := c.Do(ctx, http.MethodPatch, url, "application/vnd.api+json", &body, http.StatusOK, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Data.Attributes, nil
+}
@@ -73,7 +73,7 @@ func (c *Client) CreateOrganizationServiceAccount(ctx context.Context, orgID str
 		return nil, err
 	}
 
-	url := fmt.Sprintf("%s/v3/orgs/%s/service_accounts", c.url, orgID)
+	url := fmt.Sprintf("%s/v3/orgs/%s/service_accounts", c.URL, orgID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &body)
 	if err != nil {
@@ -81,13 +81,13 @@ func (c *Client) CreateOrganizationServiceAccount(ctx context.Context, orgID str
 	}
 
 	query := req.URL.Query()
-	query.Set("version", c.version)
+	query.Set("version", c.Version)
 	req.URL.RawQuery = query.Encode()
 
 	req.Header.Set("Content-Type", "application/vnd.api+json")
-	req.Header.Set("Authorization", c.authorization)
+	req.Header.Set("Authorization", c.Authorization)
 
-	res, err := c.httpClient.Do(req)
+	res, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +117,7 @@ func (c *Client) CreateOrganizationServiceAccount(ctx context.Context, orgID str
 }
 
 func (c *Client) DeleteOrganizationServiceAccount(ctx context.Context, orgID, saID string) (e error) {
-	url := fmt.Sprintf("%s/v3/orgs/%s/service_accounts/%s", c.url, orgID, saID)
+	url := fmt.Sprintf("%s/v3/orgs/%s/service_accounts/%s", c.URL, orgID, saID)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	if err != nil {
@@ -125,13 +125,13 @@ func (c *Client) DeleteOrganizationServiceAccount(ctx context.Context, orgID, sa
 	}
 
 	query := req.URL.Query()
-	query.Set("version", c.version)
+	query.Set("version", c.Version)
 	req.URL.RawQuery = query.Encode()
 
 	req.Header.Set("Content-Type", "application/vnd.api+json")
-	req.Header.Set("Authorization", c.authorization)
+	req.Header.Set("Authorization", c.Authorization)
 
-	res, err := c.httpClient.Do(req)
+	res, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return err
 	}
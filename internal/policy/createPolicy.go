@@ -0,0 +1,64 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type policyRequest struct {
+	Data struct {
+		ID         string      `json:"id,omitempty"`
+		Type       string      `json:"type"`
+		Attributes interface{} `json:"attributes"`
+	} `json:"data"`
+}
+
+// encodePolicy returns the body of a request creating or updating the policy
+// id with the given attributes.
+func encodePolicy(id string, attributes interface{}) (*bytes.Buffer, error) {
+	var request policyRequest
+	request.Data.ID = id
+	request.Data.Type = "policy"
+	request.Data.Attributes = attributes
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+	return &body, nil
+}
+
+// CreatePolicy creates a policy in the group groupID. The ID of policy is
+// ignored.
+func (c *Client) CreatePolicy(ctx context.Context, groupID string, policy Policy) (*Policy, error) {
+	body, err := encodePolicy("", &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/groups/%s/policies", c.URL, groupID))
+
+	var resp policyResponse
+	if err := c.Do(ctx, http.MethodPost, url, "application/vnd.api+json", body, http.StatusCreated, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data.policy(), nil
+}
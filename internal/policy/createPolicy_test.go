@@ -0,0 +1,102 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCreatePolicy(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/groups/group/policies" || r.URL.Query().Get("version") != VERSION {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		if err := json.Unmarshal(body, &request); err != nil {
+			t.Error(err)
+		}
+		// The created policy has the attributes of the request.
+		request["data"].(map[string]interface{})["id"] = "policy"
+		response, err := json.Marshal(request)
+		if err != nil {
+			t.Error(err)
+		}
+		delete(request["data"].(map[string]interface{}), "id")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(response)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{URL: server.URL, Token: "token", Version: VERSION})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	severity := "low"
+	cvssScoreMax := 4.0
+	p := Policy{
+		Name: "Downgrade",
+		Type: TYPE_SECURITY,
+		Attachments: Attachments{
+			OrganizationIDs: &[]string{"org"},
+		},
+		Rules: []Rule{{
+			Name:       "Low CVSS",
+			Conditions: Conditions{CvssScoreMax: &cvssScoreMax},
+			Action:     Action{Type: ACTION_CHANGE_SEVERITY, Severity: &severity},
+		}},
+	}
+
+	res, err := client.CreatePolicy(context.Background(), "group", p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPolicy := p
+	expectedPolicy.ID = "policy"
+	if !reflect.DeepEqual(res, &expectedPolicy) {
+		t.Errorf("expected policy %v, got %v", expectedPolicy, res)
+	}
+
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "policy",
+			"attributes": map[string]interface{}{
+				"name":        "Downgrade",
+				"description": "",
+				"type":        "security",
+				"attachments": map[string]interface{}{"organization_ids": []interface{}{"org"}},
+				"rules": []interface{}{map[string]interface{}{
+					"name":       "Low CVSS",
+					"conditions": map[string]interface{}{"cvss_score_max": 4.0},
+					"action":     map[string]interface{}{"type": "change_severity", "severity": "low"},
+				}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(request, expected) {
+		t.Errorf("expected request %v, got %v", expected, request)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+const (
+	TYPE_SECURITY = "security"
+	TYPE_LICENSE  = "license"
+)
+
+const (
+	ACTION_CHANGE_SEVERITY      = "change_severity"
+	ACTION_IGNORE               = "ignore"
+	ACTION_SET_LICENSE_SEVERITY = "set_license_severity"
+)
+
+var (
+	Types             = []string{TYPE_SECURITY, TYPE_LICENSE}
+	Actions           = []string{ACTION_CHANGE_SEVERITY, ACTION_IGNORE, ACTION_SET_LICENSE_SEVERITY}
+	Severities        = []string{"critical", "high", "medium", "low"}
+	LicenseSeverities = []string{"high", "medium", "low", "none"}
+	ExploitMaturities = []string{"mature", "proof-of-concept", "no-known-exploit", "no-data"}
+	IgnoreTypes       = []string{"wont-fix", "not-vulnerable", "temporary-ignore"}
+)
+
+// Policy is a security or license policy of a group, applied to the projects
+// of the organizations it is attached to.
+type Policy struct {
+	ID          string      `json:"-"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Type        string      `json:"type"`
+	Attachments Attachments `json:"attachments"`
+	Rules       []Rule      `json:"rules"`
+}
+
+// Attachments select the projects a policy applies to, by organization or by
+// project attributes.
+type Attachments struct {
+	OrganizationIDs   *[]string          `json:"organization_ids,omitempty"`
+	ProjectAttributes *ProjectAttributes `json:"project_attributes,omitempty"`
+}
+
+type ProjectAttributes struct {
+	Criticality *[]string `json:"criticality,omitempty"`
+	Environment *[]string `json:"environment,omitempty"`
+	Lifecycle   *[]string `json:"lifecycle,omitempty"`
+}
+
+// Rule applies its action to the issues matching all its conditions.
+type Rule struct {
+	Name       string     `json:"name"`
+	Conditions Conditions `json:"conditions"`
+	Action     Action     `json:"action"`
+}
+
+type Conditions struct {
+	Severities        *[]string `json:"severities,omitempty"`
+	ExploitMaturities *[]string `json:"exploit_maturities,omitempty"`
+	CvssScoreMin      *float64  `json:"cvss_score_min,omitempty"`
+	CvssScoreMax      *float64  `json:"cvss_score_max,omitempty"`
+	LicenseTypes      *[]string `json:"license_types,omitempty"`
+}
+
+type Action struct {
+	Type         string  `json:"type"`
+	Severity     *string `json:"severity,omitempty"`
+	IgnoreType   *string `json:"ignore_type,omitempty"`
+	Reason       *string `json:"reason,omitempty"`
+	Instructions *string `json:"instructions,omitempty"`
+}
+
+type policyData struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes Policy `json:"attributes"`
+}
+
+type policyResponse struct {
+	Data policyData `json:"data"`
+}
+
+func (d *policyData) policy() *Policy {
+	policy := d.Attributes
+	policy.ID = d.ID
+	if policy.Rules == nil {
+		policy.Rules = []Rule{}
+	}
+	return &policy
+}
+
+// GetPolicy returns the policy id of the group groupID, or nil if there is no
+// such policy.
+func (c *Client) GetPolicy(ctx context.Context, groupID string, id string) (*Policy, error) {
+	var resp policyResponse
+	err := c.GetREST(ctx, fmt.Sprintf("%s/rest/groups/%s/policies/%s", c.URL, groupID, id), &resp)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data.policy(), nil
+}
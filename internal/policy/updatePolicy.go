@@ -0,0 +1,67 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// policyUpdate holds the attributes of a request updating a policy. The
+// attachments are sent in full, with null for the removed ones, as the
+// attributes of the request are merged into the policy.
+type policyUpdate struct {
+	*Policy
+	Attachments attachmentsUpdate `json:"attachments"`
+}
+
+type attachmentsUpdate struct {
+	OrganizationIDs   *[]string                `json:"organization_ids"`
+	ProjectAttributes *projectAttributesUpdate `json:"project_attributes"`
+}
+
+type projectAttributesUpdate struct {
+	Criticality *[]string `json:"criticality"`
+	Environment *[]string `json:"environment"`
+	Lifecycle   *[]string `json:"lifecycle"`
+}
+
+// UpdatePolicy replaces the policy policy.ID of the group groupID. The type
+// of a policy cannot be changed.
+func (c *Client) UpdatePolicy(ctx context.Context, groupID string, policy Policy) (*Policy, error) {
+	update := policyUpdate{Policy: &policy}
+	update.Attachments.OrganizationIDs = policy.Attachments.OrganizationIDs
+	if attributes := policy.Attachments.ProjectAttributes; attributes != nil {
+		update.Attachments.ProjectAttributes = &projectAttributesUpdate{
+			Criticality: attributes.Criticality,
+			Environment: attributes.Environment,
+			Lifecycle:   attributes.Lifecycle,
+		}
+	}
+	body, err := encodePolicy(policy.ID, &update)
+	if err != nil {
+		return nil, err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/groups/%s/policies/%s", c.URL, groupID, policy.ID))
+
+	var resp policyResponse
+	if err := c.Do(ctx, http.MethodPatch, url, "application/vnd.api+json", body, http.StatusOK, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data.policy(), nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestUpdatePolicyClearsAttachments(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/rest/groups/group/policies/policy" || r.URL.Query().Get("version") != VERSION {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		if err := json.Unmarshal(body, &request); err != nil {
+			t.Error(err)
+		}
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{URL: server.URL, Token: "token", Version: VERSION})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lifecycle := []string{"production"}
+	p := Policy{
+		ID:   "policy",
+		Name: "Ignore",
+		Type: TYPE_LICENSE,
+		Attachments: Attachments{
+			ProjectAttributes: &ProjectAttributes{Lifecycle: &lifecycle},
+		},
+		Rules: []Rule{},
+	}
+
+	res, err := client.UpdatePolicy(context.Background(), "group", p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res, &p) {
+		t.Errorf("expected policy %v, got %v", p, res)
+	}
+
+	expected := map[string]interface{}{
+		"organization_ids": nil,
+		"project_attributes": map[string]interface{}{
+			"criticality": nil,
+			"environment": nil,
+			"lifecycle":   []interface{}{"production"},
+		},
+	}
+	attachments := request["data"].(map[string]interface{})["attributes"].(map[string]interface{})["attachments"]
+	if !reflect.DeepEqual(attachments, expected) {
+		t.Errorf("expected attachments %v, got %v", expected, attachments)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+// DeleteIgnore removes the ignore of the issue issueID of a project for path,
+// leaving the ignores of the other paths unchanged.
+func (c *Client) DeleteIgnore(ctx context.Context, orgID string, projectID string, issueID string, path string) error {
+	ignores, err := c.GetIgnores(ctx, orgID, projectID, issueID)
+	if err != nil {
+		return err
+	}
+
+	remaining := []Ignore{}
+	for _, ignore := range ignores {
+		if ignore.Path != path {
+			remaining = append(remaining, ignore)
+		}
+	}
+
+	if len(remaining) == len(ignores) {
+		// The ignore was already removed.
+		return nil
+	}
+	if len(remaining) > 0 {
+		return c.ReplaceIgnores(ctx, orgID, projectID, issueID, remaining)
+	}
+
+	url := fmt.Sprintf("%s/v1/org/%s/project/%s/ignore/%s", c.URL, orgID, projectID, issueID)
+
+	err = c.Do(ctx, http.MethodDelete, url, "application/json", nil, http.StatusOK, nil)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		// The project is already gone.
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestDeleteIgnore(t *testing.T) {
+	var replaced []Ignore
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/org/org/project/project/ignore/SNYK-JS-LODASH-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"*":{"reason":"all","reasonType":"wont-fix","disregardIfFixable":true}},
+				{"lodash@4.17.4":{"reason":"dev only","reasonType":"not-vulnerable","expires":"2030-01-01T00:00:00.000Z"}}]`)
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&replaced); err != nil {
+				t.Error(err)
+			}
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{URL: server.URL, Token: "token", Version: VERSION})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteIgnore(context.Background(), "org", "project", "SNYK-JS-LODASH-1", "lodash@4.17.4"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Ignore{{Path: IGNORE_ALL_PATHS, Reason: "all", ReasonType: IGNORE_WONT_FIX, DisregardIfFixable: true}}
+	if !reflect.DeepEqual(replaced, expected) {
+		t.Errorf("expected the remaining ignores %+v, got %+v", expected, replaced)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+func (c *Client) DeleteProject(ctx context.Context, orgID string, projectID string) error {
+	url := fmt.Sprintf("%s/v1/org/%s/project/%s", c.URL, orgID, projectID)
+
+	err := c.Do(ctx, http.MethodDelete, url, "application/json", nil, http.StatusOK, nil)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		// The project was already deleted.
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+const (
+	IGNORE_NOT_VULNERABLE   = "not-vulnerable"
+	IGNORE_WONT_FIX         = "wont-fix"
+	IGNORE_TEMPORARY_IGNORE = "temporary-ignore"
+
+	// IGNORE_ALL_PATHS is the path of the ignores applying to all the paths
+	// of an issue.
+	IGNORE_ALL_PATHS = "*"
+)
+
+var IgnoreReasonTypes = []string{IGNORE_NOT_VULNERABLE, IGNORE_WONT_FIX, IGNORE_TEMPORARY_IGNORE}
+
+// Ignore of an issue of a project, for the dependency path Path or for all
+// the paths. Expires is empty for ignores which do not expire.
+type Ignore struct {
+	Path               string `json:"ignorePath"`
+	Reason             string `json:"reason"`
+	ReasonType         string `json:"reasonType"`
+	DisregardIfFixable bool   `json:"disregardIfFixable"`
+	Expires            string `json:"expires,omitempty"`
+}
+
+// ignoreResponse is the ignore of a path, keyed by the path.
+type ignoreResponse map[string]struct {
+	Reason             string `json:"reason"`
+	ReasonType         string `json:"reasonType"`
+	DisregardIfFixable bool   `json:"disregardIfFixable"`
+	Expires            string `json:"expires"`
+}
+
+// GetIgnores returns the ignores of the issue issueID of a project.
+func (c *Client) GetIgnores(ctx context.Context, orgID string, projectID string, issueID string) ([]Ignore, error) {
+	var resp []ignoreResponse
+	err := c.Get(ctx, fmt.Sprintf("%s/v1/org/%s/project/%s/ignore/%s", c.URL, orgID, projectID, issueID), &resp)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		return []Ignore{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ignores := []Ignore{}
+	for _, paths := range resp {
+		for path, ignore := range paths {
+			ignores = append(ignores, Ignore{
+				Path:               path,
+				Reason:             ignore.Reason,
+				ReasonType:         ignore.ReasonType,
+				DisregardIfFixable: ignore.DisregardIfFixable,
+				Expires:            ignore.Expires,
+			})
+		}
+	}
+
+	return ignores, nil
+}
+
+// GetIgnore returns the ignore of the issue issueID of a project for path, or
+// nil if there is no such ignore.
+func (c *Client) GetIgnore(ctx context.Context, orgID string, projectID string, issueID string, path string) (*Ignore, error) {
+	ignores, err := c.GetIgnores(ctx, orgID, projectID, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range ignores {
+		if ignores[i].Path == path {
+			return &ignores[i], nil
+		}
+	}
+
+	return nil, nil
+}
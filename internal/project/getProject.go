@@ -0,0 +1,190 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+const (
+	STATUS_ACTIVE   = "active"
+	STATUS_INACTIVE = "inactive"
+)
+
+var (
+	BusinessCriticalities = []string{"critical", "high", "medium", "low"}
+	Environments          = []string{"frontend", "backend", "internal", "external", "mobile", "saas", "onprem", "hosted", "distributed"}
+	Lifecycles            = []string{"production", "development", "sandbox"}
+	TestFrequencies       = []string{"daily", "weekly", "never"}
+)
+
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type Project struct {
+	ID                  string
+	Name                string
+	Type                string
+	TargetID            string
+	TargetFile          string
+	TargetReference     string
+	Origin              string
+	Status              string
+	BusinessCriticality []string
+	Environment         []string
+	Lifecycle           []string
+	Tags                []Tag
+	TestFrequency       string
+	OwnerID             string
+}
+
+type relationship struct {
+	Data *struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"data"`
+}
+
+func (r relationship) id() string {
+	if r.Data == nil {
+		return ""
+	}
+	return r.Data.ID
+}
+
+type projectData struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Name                string   `json:"name"`
+		Type                string   `json:"type"`
+		TargetFile          string   `json:"target_file"`
+		TargetReference     string   `json:"target_reference"`
+		Origin              string   `json:"origin"`
+		Status              string   `json:"status"`
+		BusinessCriticality []string `json:"business_criticality"`
+		Environment         []string `json:"environment"`
+		Lifecycle           []string `json:"lifecycle"`
+		Tags                []Tag    `json:"tags"`
+		Settings            struct {
+			RecurringTests struct {
+				Frequency string `json:"frequency"`
+			} `json:"recurring_tests"`
+		} `json:"settings"`
+	} `json:"attributes"`
+	Relationships struct {
+		Target relationship `json:"target"`
+		Owner  relationship `json:"owner"`
+	} `json:"relationships"`
+}
+
+func (d *projectData) project() *Project {
+	return &Project{
+		ID:                  d.ID,
+		Name:                d.Attributes.Name,
+		Type:                d.Attributes.Type,
+		TargetID:            d.Relationships.Target.id(),
+		TargetFile:          d.Attributes.TargetFile,
+		TargetReference:     d.Attributes.TargetReference,
+		Origin:              d.Attributes.Origin,
+		Status:              d.Attributes.Status,
+		BusinessCriticality: nonNil(d.Attributes.BusinessCriticality),
+		Environment:         nonNil(d.Attributes.Environment),
+		Lifecycle:           nonNil(d.Attributes.Lifecycle),
+		Tags:                nonNil(d.Attributes.Tags),
+		TestFrequency:       d.Attributes.Settings.RecurringTests.Frequency,
+		OwnerID:             d.Relationships.Owner.id(),
+	}
+}
+
+func nonNil[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
+
+type projectResponse struct {
+	Data projectData `json:"data"`
+}
+
+// GetProject returns a project. It returns a StatusError with
+// http.StatusNotFound if the project does not exist.
+func (c *Client) GetProject(ctx context.Context, orgID string, projectID string) (*Project, error) {
+	var resp projectResponse
+	if err := c.GetREST(ctx, fmt.Sprintf("%s/rest/orgs/%s/projects/%s", c.URL, orgID, projectID), &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data.project(), nil
+}
+
+// ProjectFilter restricts the projects returned by ListProjects. Empty
+// fields do not filter.
+type ProjectFilter struct {
+	TargetID        string
+	TargetFile      string
+	TargetReference string
+	Names           []string
+	Origins         []string
+	Types           []string
+	Tags            []Tag
+}
+
+func (f *ProjectFilter) query() url.Values {
+	query := url.Values{}
+	query.Set("limit", "100")
+	setQuery(query, "target_id", f.TargetID)
+	setQuery(query, "target_file", f.TargetFile)
+	setQuery(query, "target_reference", f.TargetReference)
+	setQuery(query, "names", strings.Join(f.Names, ","))
+	setQuery(query, "origins", strings.Join(f.Origins, ","))
+	setQuery(query, "types", strings.Join(f.Types, ","))
+	tags := []string{}
+	for _, tag := range f.Tags {
+		tags = append(tags, tag.Key+":"+tag.Value)
+	}
+	setQuery(query, "tags", strings.Join(tags, ","))
+	return query
+}
+
+func setQuery(query url.Values, key string, value string) {
+	if value != "" {
+		query.Set(key, value)
+	}
+}
+
+// ListProjects returns all the projects of an organization matching filter,
+// following the pagination of the API.
+func (c *Client) ListProjects(ctx context.Context, orgID string, filter ProjectFilter) ([]*Project, error) {
+	data, err := snyk_http.GetAllPages[projectData](ctx, c.APIClient, fmt.Sprintf("%s/rest/orgs/%s/projects?%s", c.URL, orgID, filter.query().Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	projects := []*Project{}
+	for i := range data {
+		projects = append(projects, data[i].project())
+	}
+
+	return projects, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListProjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/orgs/org/projects" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		query := r.URL.Query()
+		if query.Get("version") != VERSION || query.Get("target_id") != "target" || query.Get("tags") != "team:a,env:prod" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		if query.Get("starting_after") == "" {
+			fmt.Fprintf(w, `{"data":[{"id":"p1","attributes":{"name":"goof","tags":[{"key":"team","value":"a"}]},
+				"relationships":{"target":{"data":{"id":"target","type":"target"}}}}],
+				"links":{"next":"/orgs/org/projects?%s&starting_after=p1"}}`, r.URL.RawQuery)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":"p2","attributes":{"name":"goof","status":"inactive"},"relationships":{"owner":{"data":null}}}],"links":{}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{URL: server.URL, Token: "token", Version: VERSION})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	projects, err := client.ListProjects(context.Background(), "org", ProjectFilter{
+		TargetID: "target",
+		Tags:     []Tag{{Key: "team", Value: "a"}, {Key: "env", Value: "prod"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []*Project{
+		{ID: "p1", Name: "goof", TargetID: "target", BusinessCriticality: []string{}, Environment: []string{}, Lifecycle: []string{}, Tags: []Tag{{Key: "team", Value: "a"}}},
+		{ID: "p2", Name: "goof", Status: STATUS_INACTIVE, BusinessCriticality: []string{}, Environment: []string{}, Lifecycle: []string{}, Tags: []Tag{}},
+	}
+	if !reflect.DeepEqual(projects, expected) {
+		t.Errorf("expected %+v, got %+v", expected, projects)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+type Target struct {
+	ID            string
+	DisplayName   string
+	URL           string
+	IsPrivate     bool
+	IntegrationID string
+	SourceType    string
+}
+
+type targetData struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		DisplayName string `json:"display_name"`
+		URL         string `json:"url"`
+		IsPrivate   bool   `json:"is_private"`
+	} `json:"attributes"`
+	Relationships struct {
+		Integration struct {
+			Data *struct {
+				ID         string `json:"id"`
+				Attributes struct {
+					IntegrationType string `json:"integration_type"`
+				} `json:"attributes"`
+			} `json:"data"`
+		} `json:"integration"`
+	} `json:"relationships"`
+}
+
+func (d *targetData) target() *Target {
+	target := &Target{
+		ID:          d.ID,
+		DisplayName: d.Attributes.DisplayName,
+		URL:         d.Attributes.URL,
+		IsPrivate:   d.Attributes.IsPrivate,
+	}
+	if integration := d.Relationships.Integration.Data; integration != nil {
+		target.IntegrationID = integration.ID
+		target.SourceType = integration.Attributes.IntegrationType
+	}
+	return target
+}
+
+type targetResponse struct {
+	Data targetData `json:"data"`
+}
+
+// GetTarget returns a target. It returns a StatusError with
+// http.StatusNotFound if the target does not exist.
+func (c *Client) GetTarget(ctx context.Context, orgID string, targetID string) (*Target, error) {
+	var resp targetResponse
+	if err := c.GetREST(ctx, fmt.Sprintf("%s/rest/orgs/%s/targets/%s", c.URL, orgID, targetID), &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data.target(), nil
+}
+
+// TargetFilter restricts the targets returned by ListTargets. Empty fields
+// do not filter.
+type TargetFilter struct {
+	DisplayName string
+	SourceTypes []string
+	IsPrivate   *bool
+	URL         string
+}
+
+func (f *TargetFilter) query() url.Values {
+	query := url.Values{}
+	query.Set("limit", "100")
+	setQuery(query, "display_name", f.DisplayName)
+	setQuery(query, "source_types", strings.Join(f.SourceTypes, ","))
+	setQuery(query, "url", f.URL)
+	if f.IsPrivate != nil {
+		query.Set("is_private", strconv.FormatBool(*f.IsPrivate))
+	}
+	return query
+}
+
+// ListTargets returns all the targets of an organization matching filter,
+// following the pagination of the API.
+func (c *Client) ListTargets(ctx context.Context, orgID string, filter TargetFilter) ([]*Target, error) {
+	data, err := snyk_http.GetAllPages[targetData](ctx, c.APIClient, fmt.Sprintf("%s/rest/orgs/%s/targets?%s", c.URL, orgID, filter.query().Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []*Target{}
+	for i := range data {
+		targets = append(targets, data[i].target())
+	}
+
+	return targets, nil
+}
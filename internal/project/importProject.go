@@ -0,0 +1,141 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+)
+
+const (
+	IMPORT_STATUS_PENDING  = "pending"
+	IMPORT_STATUS_FAILED   = "failed"
+	IMPORT_STATUS_COMPLETE = "complete"
+)
+
+// ImportTarget identifies what is imported: a repository by owner, name and
+// branch, or an image by name.
+type ImportTarget struct {
+	Owner  string `json:"owner,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Branch string `json:"branch,omitempty"`
+}
+
+type ImportFile struct {
+	Path string `json:"path"`
+}
+
+type ImportRequest struct {
+	Target         ImportTarget `json:"target"`
+	Files          []ImportFile `json:"files,omitempty"`
+	ExclusionGlobs string       `json:"exclusionGlobs,omitempty"`
+}
+
+type ImportJob struct {
+	ID     string         `json:"id"`
+	Status string         `json:"status"`
+	Logs   []ImportJobLog `json:"logs"`
+}
+
+type ImportJobLog struct {
+	Name     string             `json:"name"`
+	Status   string             `json:"status"`
+	Projects []ImportJobProject `json:"projects"`
+}
+
+type ImportJobProject struct {
+	TargetFile string `json:"targetFile"`
+	Success    bool   `json:"success"`
+	ProjectID  string `json:"projectId"`
+	ProjectURL string `json:"projectUrl"`
+}
+
+// ProjectIDs returns the ids of the projects successfully created by the job.
+func (j *ImportJob) ProjectIDs() []string {
+	ids := []string{}
+	for _, log := range j.Logs {
+		for _, project := range log.Projects {
+			if project.Success && project.ProjectID != "" {
+				ids = append(ids, project.ProjectID)
+			}
+		}
+	}
+	return ids
+}
+
+// ImportProject starts importing a target through an integration, and
+// returns the id of the import job.
+func (c *Client) ImportProject(ctx context.Context, orgID string, integrationID string, request *ImportRequest) (string, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return "", err
+	}
+
+	url := fmt.Sprintf("%s/v1/org/%s/integrations/%s/import", c.URL, orgID, integrationID)
+
+	header, err := c.DoWithHeader(ctx, http.MethodPost, url, "application/json", &body, http.StatusCreated, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return importJobID(header.Get("Location"))
+}
+
+// importJobID extracts the job id from the Location of an import job, e.g.
+// https://api.snyk.io/v1/org/{org}/integrations/{integration}/import/{id}.
+func importJobID(location string) (string, error) {
+	parsedURL, err := url.Parse(location)
+	if err != nil || location == "" {
+		return "", fmt.Errorf("invalid import job location: %q", location)
+	}
+
+	return path.Base(parsedURL.Path), nil
+}
+
+func (c *Client) GetImportJob(ctx context.Context, orgID string, integrationID string, jobID string) (*ImportJob, error) {
+	var job ImportJob
+	if err := c.Get(ctx, fmt.Sprintf("%s/v1/org/%s/integrations/%s/import/%s", c.URL, orgID, integrationID, jobID), &job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// WaitForImportJob polls an import job every interval until it is no longer
+// pending, or ctx is done.
+func (c *Client) WaitForImportJob(ctx context.Context, orgID string, integrationID string, jobID string, interval time.Duration) (*ImportJob, error) {
+	for {
+		job, err := c.GetImportJob(ctx, orgID, integrationID, jobID)
+		if err != nil {
+			return nil, err
+		}
+
+		if job.Status != IMPORT_STATUS_PENDING {
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("import job %s is still pending: %w", jobID, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
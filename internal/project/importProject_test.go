@@ -0,0 +1,72 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestImportProject(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/org/org/integrations/integration/import":
+			w.Header().Set("Location", "https://api.snyk.io/v1/org/org/integrations/integration/import/job")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/org/org/integrations/integration/import/job":
+			polls++
+			status := IMPORT_STATUS_PENDING
+			if polls > 1 {
+				status = IMPORT_STATUS_COMPLETE
+			}
+			fmt.Fprintf(w, `{"id":"job","status":%q,"logs":[{"name":"snyk/goof","projects":[
+				{"targetFile":"package.json","success":true,"projectId":"p1"},
+				{"targetFile":"pom.xml","success":false}]}]}`, status)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{URL: server.URL, Token: "token", Version: VERSION})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jobID, err := client.ImportProject(context.Background(), "org", "integration", &ImportRequest{Target: ImportTarget{Owner: "snyk", Name: "goof"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jobID != "job" {
+		t.Errorf("expected job id job, got %s", jobID)
+	}
+
+	job, err := client.WaitForImportJob(context.Background(), "org", "integration", jobID, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Status != IMPORT_STATUS_COMPLETE || polls != 2 {
+		t.Errorf("expected a complete job after 2 polls, got %s after %d", job.Status, polls)
+	}
+	if ids := job.ProjectIDs(); !reflect.DeepEqual(ids, []string{"p1"}) {
+		t.Errorf("expected project ids [p1], got %v", ids)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AddIgnore ignores the issue issueID of a project for ignore.Path, in
+// addition to its other ignores.
+func (c *Client) AddIgnore(ctx context.Context, orgID string, projectID string, issueID string, ignore Ignore) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(ignore); err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v1/org/%s/project/%s/ignore/%s", c.URL, orgID, projectID, issueID)
+
+	return c.Do(ctx, http.MethodPost, url, "application/json", &body, http.StatusOK, nil)
+}
+
+// ReplaceIgnores replaces all the ignores of the issue issueID of a project.
+func (c *Client) ReplaceIgnores(ctx context.Context, orgID string, projectID string, issueID string, ignores []Ignore) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(ignores); err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v1/org/%s/project/%s/ignore/%s", c.URL, orgID, projectID, issueID)
+
+	return c.Do(ctx, http.MethodPut, url, "application/json", &body, http.StatusOK, nil)
+}
+
+// UpdateIgnore replaces the ignore of the issue issueID of a project for
+// ignore.Path, leaving the ignores of the other paths unchanged.
+func (c *Client) UpdateIgnore(ctx context.Context, orgID string, projectID string, issueID string, ignore Ignore) error {
+	ignores, err := c.GetIgnores(ctx, orgID, projectID, issueID)
+	if err != nil {
+		return err
+	}
+
+	updated := []Ignore{ignore}
+	for _, other := range ignores {
+		if other.Path != ignore.Path {
+			updated = append(updated, other)
+		}
+	}
+
+	return c.ReplaceIgnores(ctx, orgID, projectID, issueID, updated)
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package project
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ProjectUpdate holds the attributes of a project to change. Nil fields are
+// left unchanged, and an empty OwnerID removes the owner of the project.
+type ProjectUpdate struct {
+	BusinessCriticality *[]string
+	Environment         *[]string
+	Lifecycle           *[]string
+	Tags                *[]Tag
+	TestFrequency       *string
+	OwnerID             *string
+}
+
+type projectUpdateAttributes struct {
+	BusinessCriticality *[]string `json:"business_criticality,omitempty"`
+	Environment         *[]string `json:"environment,omitempty"`
+	Lifecycle           *[]string `json:"lifecycle,omitempty"`
+	Tags                *[]Tag    `json:"tags,omitempty"`
+	TestFrequency       *string   `json:"test_frequency,omitempty"`
+}
+
+type ownerRelationship struct {
+	Data *ownerRelationshipData `json:"data"`
+}
+
+type ownerRelationshipData struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type projectUpdateRelationships struct {
+	Owner ownerRelationship `json:"owner"`
+}
+
+type projectUpdateRequest struct {
+	Data struct {
+		ID            string                      `json:"id"`
+		Type          string                      `json:"type"`
+		Attributes    projectUpdateAttributes     `json:"attributes"`
+		Relationships *projectUpdateRelationships `json:"relationships,omitempty"`
+	} `json:"data"`
+}
+
+// UpdateProject changes the attributes of a project, and returns the updated
+// project.
+func (c *Client) UpdateProject(ctx context.Context, orgID string, projectID string, update *ProjectUpdate) (*Project, error) {
+	var request projectUpdateRequest
+	request.Data.ID = projectID
+	request.Data.Type = "project"
+	request.Data.Attributes = projectUpdateAttributes{
+		BusinessCriticality: update.BusinessCriticality,
+		Environment:         update.Environment,
+		Lifecycle:           update.Lifecycle,
+		Tags:                update.Tags,
+		TestFrequency:       update.TestFrequency,
+	}
+	if update.OwnerID != nil {
+		request.Data.Relationships = &projectUpdateRelationships{}
+		if *update.OwnerID != "" {
+			request.Data.Relationships.Owner.Data = &ownerRelationshipData{ID: *update.OwnerID, Type: "user"}
+		}
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/orgs/%s/projects/%s", c.URL, orgID, projectID))
+
+	var resp projectResponse
+	if err := c.Do(ctx, http.MethodPatch, url, "application/vnd.api+json", &body, http.StatusOK, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data.project(), nil
+}
+
+// ActivateProject resumes the testing of a deactivated project.
+func (c *Client) ActivateProject(ctx context.Context, orgID string, projectID string) error {
+	url := fmt.Sprintf("%s/v1/org/%s/project/%s/activate", c.URL, orgID, projectID)
+	return c.Do(ctx, http.MethodPost, url, "application/json", nil, http.StatusOK, nil)
+}
+
+// DeactivateProject stops the testing of a project, and the pull request
+// checks of its repository.
+func (c *Client) DeactivateProject(ctx context.Context, orgID string, projectID string) error {
+	url := fmt.Sprintf("%s/v1/org/%s/project/%s/deactivate", c.URL, orgID, projectID)
+	return c.Do(ctx, http.MethodPost, url, "application/json", nil, http.StatusOK, nil)
+}
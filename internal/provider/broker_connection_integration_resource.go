@@ -0,0 +1,217 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &BrokerConnectionIntegrationResource{}
+var _ resource.ResourceWithImportState = &BrokerConnectionIntegrationResource{}
+
+func NewBrokerConnectionIntegrationResource() resource.Resource {
+	return &BrokerConnectionIntegrationResource{}
+}
+
+// BrokerConnectionIntegrationResource defines the resource implementation.
+type BrokerConnectionIntegrationResource struct {
+	client snykclient.Client
+}
+
+// BrokerConnectionIntegrationResourceModel describes the resource data model.
+type BrokerConnectionIntegrationResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	TenantId       types.String `tfsdk:"tenant_id"`
+	ConnectionId   types.String `tfsdk:"connection_id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	IntegrationId  types.String `tfsdk:"integration_id"`
+}
+
+func (r *BrokerConnectionIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_broker_connection_integration"
+}
+
+// requiredReplacingString returns the schema of a required string attribute
+// which replaces the resource when changed.
+func requiredReplacingString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func (r *BrokerConnectionIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Makes an integration of a Snyk organization use a Universal Broker connection. Destroying this resource stops the integration from using the connection.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "connection_id/organization_id/integration_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tenant_id":       requiredReplacingString("Snyk Tenant GUID"),
+			"connection_id":   requiredReplacingString("ID of the Broker connection, e.g. `snyk_broker_connection.example.id`"),
+			"organization_id": requiredReplacingString("Snyk Organization GUID"),
+			"integration_id":  requiredReplacingString("ID of the integration of the organization, e.g. `snyk_integration.example.id`"),
+		},
+	}
+}
+
+func (r *BrokerConnectionIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+func (r *BrokerConnectionIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *BrokerConnectionIntegrationResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse BrokerConnectionIntegration Organization Guid, got error: %s", err))
+		return
+	}
+
+	err = r.client.BrokerClient.BindIntegration(ctx, plan.TenantId.ValueString(), plan.ConnectionId.ValueString(), plan.OrganizationId.ValueString(), plan.IntegrationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create BrokerConnectionIntegration, got error: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(strings.Join([]string{plan.ConnectionId.ValueString(), plan.OrganizationId.ValueString(), plan.IntegrationId.ValueString()}, "/"))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BrokerConnectionIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *BrokerConnectionIntegrationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrations, err := r.client.BrokerClient.ListIntegrations(ctx, data.TenantId.ValueString(), data.ConnectionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read BrokerConnectionIntegration, got error: %s", err))
+		return
+	}
+
+	found := false
+	for _, integration := range integrations {
+		found = found || (integration.ID == data.IntegrationId.ValueString() && integration.OrgID == data.OrganizationId.ValueString())
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BrokerConnectionIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the attributes require a replacement, so only the plan is saved.
+	var plan *BrokerConnectionIntegrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BrokerConnectionIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *BrokerConnectionIntegrationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.BrokerClient.UnbindIntegration(ctx, data.TenantId.ValueString(), data.ConnectionId.ValueString(), data.OrganizationId.ValueString(), data.IntegrationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete BrokerConnectionIntegration, got error: %s", err))
+		return
+	}
+}
+
+func (r *BrokerConnectionIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format tenant_id/connection_id/organization_id/integration_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join(parts[1:], "/"))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_id"), parts[3])...)
+}
@@ -0,0 +1,346 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/broker"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &BrokerConnectionResource{}
+var _ resource.ResourceWithImportState = &BrokerConnectionResource{}
+var _ resource.ResourceWithValidateConfig = &BrokerConnectionResource{}
+
+func NewBrokerConnectionResource() resource.Resource {
+	return &BrokerConnectionResource{}
+}
+
+// BrokerConnectionResource defines the resource implementation.
+type BrokerConnectionResource struct {
+	client snykclient.Client
+}
+
+// BrokerConnectionResourceModel describes the resource data model.
+type BrokerConnectionResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	TenantId             types.String `tfsdk:"tenant_id"`
+	DeploymentId         types.String `tfsdk:"deployment_id"`
+	Name                 types.String `tfsdk:"name"`
+	Type                 types.String `tfsdk:"type"`
+	Configuration        types.Map    `tfsdk:"configuration"`
+	CredentialReferences types.Map    `tfsdk:"credential_references"`
+}
+
+func (r *BrokerConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_broker_connection"
+}
+
+func (r *BrokerConnectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the connection of a Universal Broker deployment to a system, e.g. a self-hosted GitLab or Artifactory. " +
+			"The fields of the connection depend on its type, and are split between the `configuration` and the sensitive `credential_references`, " +
+			"which are all sent together. Imported connections have all their fields in `configuration`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Snyk Broker Connection ID, given to the broker client",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Tenant GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Broker deployment, e.g. `snyk_broker_deployment.example.id`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the connection",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the connected system, e.g. `gitlab` or `artifactory`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configuration": schema.MapAttribute{
+				MarkdownDescription: "Fields of the connection required by its type, e.g. `gitlab` for the hostname of a GitLab instance, or `broker_client_url`",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"credential_references": schema.MapAttribute{
+				MarkdownDescription: "Fields of the connection referencing the credentials of the deployment, by the name of the environment variable holding them in the broker client, " +
+					"e.g. `gitlab_token = \"$${GITLAB_TOKEN}\"`",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *BrokerConnectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *BrokerConnectionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, diags := stringMapElements(ctx, data.Configuration)
+	resp.Diagnostics.Append(diags...)
+	credentialReferences, diags := stringMapElements(ctx, data.CredentialReferences)
+	resp.Diagnostics.Append(diags...)
+
+	for key := range credentialReferences {
+		if _, found := configuration[key]; found {
+			resp.Diagnostics.AddAttributeError(path.Root("credential_references").AtMapKey(key), "Invalid Attribute Value", fmt.Sprintf("%s cannot be in both configuration and credential_references", key))
+		}
+	}
+}
+
+func (r *BrokerConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// stringMapElements returns the elements of a map of strings, which are none
+// if it is null or unknown.
+func stringMapElements(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	elements := map[string]string{}
+	if value.IsNull() || value.IsUnknown() {
+		return elements, nil
+	}
+	diags := value.ElementsAs(ctx, &elements, false)
+	return elements, diags
+}
+
+func (m *BrokerConnectionResourceModel) connection(ctx context.Context) (broker.Connection, diag.Diagnostics) {
+	configuration, diags := stringMapElements(ctx, m.Configuration)
+	credentialReferences, credentialDiags := stringMapElements(ctx, m.CredentialReferences)
+	diags.Append(credentialDiags...)
+
+	for key, value := range credentialReferences {
+		configuration[key] = value
+	}
+
+	return broker.Connection{
+		ID:            m.Id.ValueString(),
+		DeploymentID:  m.DeploymentId.ValueString(),
+		Name:          m.Name.ValueString(),
+		Type:          m.Type.ValueString(),
+		Configuration: configuration,
+	}, diags
+}
+
+// set fills m with connection, splitting its configuration between the
+// credential references already in m and the other fields.
+func (m *BrokerConnectionResourceModel) set(ctx context.Context, connection *broker.Connection) diag.Diagnostics {
+	credentialReferences, diags := stringMapElements(ctx, m.CredentialReferences)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Only the credential references returned by the API are kept, so that
+	// removed ones show up as a difference.
+	configuration := map[string]string{}
+	returnedReferences := map[string]string{}
+	for key, value := range connection.Configuration {
+		if _, found := credentialReferences[key]; found {
+			returnedReferences[key] = value
+		} else {
+			configuration[key] = value
+		}
+	}
+
+	m.Id = types.StringValue(connection.ID)
+	m.Name = types.StringValue(connection.Name)
+	m.Type = types.StringValue(connection.Type)
+
+	var d diag.Diagnostics
+	m.Configuration, d = types.MapValueFrom(ctx, types.StringType, configuration)
+	diags.Append(d...)
+	if !m.CredentialReferences.IsNull() {
+		m.CredentialReferences, d = types.MapValueFrom(ctx, types.StringType, returnedReferences)
+		diags.Append(d...)
+	}
+	return diags
+}
+
+func (r *BrokerConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *BrokerConnectionResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.TenantId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse BrokerConnection Tenant Guid, got error: %s", err))
+		return
+	}
+
+	connection, diags := plan.connection(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.BrokerClient.CreateConnection(ctx, plan.TenantId.ValueString(), connection)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create BrokerConnection, got error: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(created.ID)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BrokerConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *BrokerConnectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, err := r.client.BrokerClient.GetConnection(ctx, data.TenantId.ValueString(), data.DeploymentId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read BrokerConnection, got error: %s", err))
+		return
+	}
+
+	if connection == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, connection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BrokerConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *BrokerConnectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, diags := plan.connection(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.BrokerClient.UpdateConnection(ctx, plan.TenantId.ValueString(), connection)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update BrokerConnection, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BrokerConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *BrokerConnectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.BrokerClient.DeleteConnection(ctx, data.TenantId.ValueString(), data.DeploymentId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete BrokerConnection, got error: %s", err))
+		return
+	}
+}
+
+func (r *BrokerConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format tenant_id/deployment_id/connection_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBrokerConnection(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	tenantId := readEnvVarOrSkip(t, "TEST_SNYK_TENANT_ID")
+	installId := readEnvVarOrSkip(t, "TEST_SNYK_BROKER_INSTALL_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForBrokerConnection(snykOrgId, tenantId, installId, "gitlab.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snyk_broker_deployment.test", "id"),
+					resource.TestCheckResourceAttr("snyk_broker_deployment.test", "metadata.cluster", "test"),
+					resource.TestCheckResourceAttrPair("snyk_broker_connection.test", "deployment_id", "snyk_broker_deployment.test", "id"),
+					resource.TestCheckResourceAttr("snyk_broker_connection.test", "configuration.gitlab", "gitlab.example.com"),
+					resource.TestCheckResourceAttr("snyk_broker_connection.test", "credential_references.gitlab_token", "${GITLAB_TOKEN}"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_broker_deployment.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccBrokerDeploymentImportStateId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForBrokerConnection(snykOrgId, tenantId, installId, "gitlab.example.org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_broker_connection.test", "configuration.gitlab", "gitlab.example.org"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBrokerDeploymentImportStateId(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["snyk_broker_deployment.test"]
+	if !ok {
+		return "", fmt.Errorf("resource snyk_broker_deployment.test not found")
+	}
+	return rs.Primary.Attributes["tenant_id"] + "/" + rs.Primary.Attributes["install_id"] + "/" + rs.Primary.ID, nil
+}
+
+func testAccExampleResourceConfigForBrokerConnection(orgId string, tenantId string, installId string, hostname string) string {
+	return fmt.Sprintf(`
+resource "snyk_broker_deployment" "test" {
+  tenant_id = %[2]q
+  install_id = %[3]q
+  app_installed_in_org_id = %[1]q
+  metadata = {
+    cluster = "test"
+  }
+}
+
+resource "snyk_broker_connection" "test" {
+  tenant_id = %[2]q
+  deployment_id = snyk_broker_deployment.test.id
+  name = "terraform-gitlab"
+  type = "gitlab"
+  configuration = {
+    gitlab = %[4]q
+    broker_client_url = "https://broker.example.com:8000"
+  }
+  credential_references = {
+    gitlab_token = "$${GITLAB_TOKEN}"
+  }
+}`, orgId, tenantId, installId, hostname)
+}
@@ -0,0 +1,285 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/broker"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &BrokerDeploymentResource{}
+var _ resource.ResourceWithImportState = &BrokerDeploymentResource{}
+
+func NewBrokerDeploymentResource() resource.Resource {
+	return &BrokerDeploymentResource{}
+}
+
+// BrokerDeploymentResource defines the resource implementation.
+type BrokerDeploymentResource struct {
+	client snykclient.Client
+}
+
+// BrokerDeploymentResourceModel describes the resource data model.
+type BrokerDeploymentResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	TenantId            types.String `tfsdk:"tenant_id"`
+	InstallId           types.String `tfsdk:"install_id"`
+	AppInstalledInOrgId types.String `tfsdk:"app_installed_in_org_id"`
+	Metadata            types.Map    `tfsdk:"metadata"`
+}
+
+func (r *BrokerDeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_broker_deployment"
+}
+
+func (r *BrokerDeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages a [Universal Broker](https://docs.snyk.io/enterprise-setup/snyk-broker/universal-broker) deployment, i.e. a broker client running on premises. " +
+			"Its ID and the credentials of its install are given to the broker client, e.g. in the values of its Helm chart.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Snyk Broker Deployment ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Tenant GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"install_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the install of the Snyk Broker App",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_installed_in_org_id": schema.StringAttribute{
+				MarkdownDescription: "GUID of the Snyk Organization the Snyk Broker App is installed in",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Free-form metadata of the deployment, e.g. the cluster it runs in",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *BrokerDeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+func (m *BrokerDeploymentResourceModel) attributes(ctx context.Context) (broker.DeploymentAttributes, diag.Diagnostics) {
+	attributes := broker.DeploymentAttributes{
+		InstallID:           m.InstallId.ValueString(),
+		AppInstalledInOrgID: m.AppInstalledInOrgId.ValueString(),
+		Metadata:            map[string]string{},
+	}
+
+	var diags diag.Diagnostics
+	if !m.Metadata.IsNull() && !m.Metadata.IsUnknown() {
+		diags = m.Metadata.ElementsAs(ctx, &attributes.Metadata, false)
+	}
+	return attributes, diags
+}
+
+func (m *BrokerDeploymentResourceModel) set(ctx context.Context, deployment *broker.Deployment) (diags diag.Diagnostics) {
+	m.Id = types.StringValue(deployment.ID)
+	m.AppInstalledInOrgId = types.StringValue(deployment.AppInstalledInOrgID)
+	metadata := deployment.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	m.Metadata, diags = types.MapValueFrom(ctx, types.StringType, metadata)
+	return
+}
+
+func (r *BrokerDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *BrokerDeploymentResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.TenantId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse BrokerDeployment Tenant Guid, got error: %s", err))
+		return
+	}
+
+	attributes, diags := plan.attributes(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.client.BrokerClient.CreateDeployment(ctx, plan.TenantId.ValueString(), attributes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create BrokerDeployment, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.set(ctx, deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *BrokerDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *BrokerDeploymentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.client.BrokerClient.GetDeployment(ctx, data.TenantId.ValueString(), data.InstallId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read BrokerDeployment, got error: %s", err))
+		return
+	}
+
+	if deployment == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.set(ctx, deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BrokerDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *BrokerDeploymentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes, diags := plan.attributes(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.client.BrokerClient.UpdateDeployment(ctx, plan.TenantId.ValueString(), plan.Id.ValueString(), attributes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update BrokerDeployment, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.set(ctx, deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *BrokerDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *BrokerDeploymentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.BrokerClient.DeleteDeployment(ctx, data.TenantId.ValueString(), data.InstallId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete BrokerDeployment, got error: %s", err))
+		return
+	}
+}
+
+func (r *BrokerDeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format tenant_id/install_id/deployment_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("install_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+}
@@ -0,0 +1,399 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &CollectionResource{}
+var _ resource.ResourceWithImportState = &CollectionResource{}
+var _ resource.ResourceWithModifyPlan = &CollectionResource{}
+var _ resource.ResourceWithValidateConfig = &CollectionResource{}
+
+func NewCollectionResource() resource.Resource {
+	return &CollectionResource{}
+}
+
+// CollectionResource defines the resource implementation.
+type CollectionResource struct {
+	client snykclient.Client
+}
+
+// CollectionResourceModel describes the resource data model.
+type CollectionResourceModel struct {
+	Id             types.String          `tfsdk:"id"`
+	OrganizationId types.String          `tfsdk:"organization_id"`
+	Name           types.String          `tfsdk:"name"`
+	ProjectIds     types.Set             `tfsdk:"project_ids"`
+	Selector       *ProjectSelectorModel `tfsdk:"selector"`
+}
+
+func (r *CollectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection"
+}
+
+func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages a [collection](https://docs.snyk.io/snyk-admin/snyk-projects/project-collections-groupings/project-collections) of projects of a Snyk organization. " +
+			"Its projects are either listed with `project_ids`, or matched with a `selector` on each apply. The projects of the collection are not managed when neither is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Snyk Collection ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the collection",
+				Required:            true,
+			},
+			"project_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the projects of the collection. Computed when `selector` is set.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("selector")),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"selector": projectSelectorAttribute(false, "Criteria the projects of the collection must all match, evaluated on each apply. All the projects of the organization are in the collection when no criteria is set."),
+		},
+	}
+}
+
+func (r *CollectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateProjectSelector(ctx, req.Config, path.Root("selector"))...)
+}
+
+func (r *CollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// selectedProjectIds returns the IDs of the projects matching the selector of
+// data, sorted.
+func (r *CollectionResource) selectedProjectIds(ctx context.Context, data *CollectionResourceModel) ([]string, diag.Diagnostics) {
+	projects, diags := selectProjects(ctx, r.client, data.OrganizationId.ValueString(), data.Selector)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ids := []string{}
+	for _, p := range projects {
+		ids = append(ids, p.ID)
+	}
+	sort.Strings(ids)
+	return ids, diags
+}
+
+// desiredProjectIds returns the IDs of the projects the collection must have
+// according to data, or nil if they are not managed.
+func (r *CollectionResource) desiredProjectIds(ctx context.Context, data *CollectionResourceModel) ([]string, diag.Diagnostics) {
+	if data.Selector != nil {
+		return r.selectedProjectIds(ctx, data)
+	}
+	if data.ProjectIds.IsNull() || data.ProjectIds.IsUnknown() {
+		return nil, nil
+	}
+
+	ids := []string{}
+	diags := stringSetElements(ctx, data.ProjectIds, &ids)
+	return ids, diags
+}
+
+// stringsDifference returns the elements of a which are not in b.
+func stringsDifference(a []string, b []string) []string {
+	inB := map[string]bool{}
+	for _, s := range b {
+		inB[s] = true
+	}
+
+	difference := []string{}
+	for _, s := range a {
+		if !inB[s] {
+			difference = append(difference, s)
+		}
+	}
+	return difference
+}
+
+// syncProjects adds and removes the projects of the collection of data so
+// that it has the projects desired, and sets them in data.
+func (r *CollectionResource) syncProjects(ctx context.Context, data *CollectionResourceModel, desired []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	orgID := data.OrganizationId.ValueString()
+
+	current, err := r.client.CollectionClient.ListProjects(ctx, orgID, data.Id.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read Collection projects, got error: %s", err))
+		return diags
+	}
+
+	if desired == nil {
+		desired = current
+	}
+
+	if err := r.client.CollectionClient.AddProjects(ctx, orgID, data.Id.ValueString(), stringsDifference(desired, current)); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to add projects to Collection, got error: %s", err))
+		return diags
+	}
+	if err := r.client.CollectionClient.RemoveProjects(ctx, orgID, data.Id.ValueString(), stringsDifference(current, desired)); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to remove projects from Collection, got error: %s", err))
+		return diags
+	}
+
+	data.ProjectIds, diags = types.SetValueFrom(ctx, types.StringType, desired)
+	return diags
+}
+
+func (r *CollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Projects are selected on creation, so only the selector of updated
+	// collections is checked.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *CollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Selector == nil {
+		return
+	}
+
+	var stateSelector, planSelector types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("selector"), &stateSelector)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("selector"), &planSelector)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed := !planSelector.Equal(stateSelector)
+	if !changed && !plan.OrganizationId.IsUnknown() {
+		// Look for projects which started or stopped matching since the last
+		// apply.
+		ids, diags := r.selectedProjectIds(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		selected, diags := types.SetValueFrom(ctx, types.StringType, ids)
+		resp.Diagnostics.Append(diags...)
+		changed = !selected.Equal(state.ProjectIds)
+	}
+
+	if changed {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("project_ids"), types.SetUnknown(types.StringType))...)
+	}
+}
+
+func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *CollectionResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse Collection Organization Guid, got error: %s", err))
+		return
+	}
+
+	desired, diags := r.desiredProjectIds(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := r.client.CollectionClient.CreateCollection(ctx, plan.OrganizationId.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Collection, got error: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(c.ID)
+	if desired == nil {
+		desired = []string{}
+	}
+	// A collection failing to get its projects is saved in the state along
+	// with the error, so that it is tainted, and its projects are unknown.
+	resp.Diagnostics.Append(r.syncProjects(ctx, plan, desired)...)
+	if plan.ProjectIds.IsUnknown() {
+		plan.ProjectIds = types.SetNull(types.StringType)
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CollectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := r.client.CollectionClient.GetCollection(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Collection, got error: %s", err))
+		return
+	}
+
+	if c == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ids, err := r.client.CollectionClient.ListProjects(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Collection projects, got error: %s", err))
+		return
+	}
+
+	data.Name = types.StringValue(c.Name)
+
+	var diags diag.Diagnostics
+	data.ProjectIds, diags = types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *CollectionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		_, err := r.client.CollectionClient.UpdateCollection(ctx, plan.OrganizationId.ValueString(), plan.Id.ValueString(), plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Collection, got error: %s", err))
+			return
+		}
+	}
+
+	desired, diags := r.desiredProjectIds(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncProjects(ctx, plan, desired)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CollectionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CollectionClient.DeleteCollection(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Collection, got error: %s", err))
+		return
+	}
+}
+
+func (r *CollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, collectionId, found := strings.Cut(req.ID, "/")
+	if !found || organizationId == "" || collectionId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format organization_id/collection_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), collectionId)...)
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCollection(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	projectId := readEnvVarOrSkip(t, "TEST_SNYK_PROJECT_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForCollection(snykOrgId, "terraform-collection", fmt.Sprintf("[%q]", projectId)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snyk_collection.test", "id"),
+					resource.TestCheckResourceAttr("snyk_collection.test", "project_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snyk_collection.test", "project_ids.*", projectId),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_collection.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccCollectionImportStateId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForCollection(snykOrgId, "terraform-collection-renamed", "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_collection.test", "name", "terraform-collection-renamed"),
+					resource.TestCheckResourceAttr("snyk_collection.test", "project_ids.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCollectionImportStateId(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["snyk_collection.test"]
+	if !ok {
+		return "", fmt.Errorf("resource snyk_collection.test not found")
+	}
+	return rs.Primary.Attributes["organization_id"] + "/" + rs.Primary.ID, nil
+}
+
+func testAccExampleResourceConfigForCollection(orgId string, name string, projectIds string) string {
+	return fmt.Sprintf(`
+resource "snyk_collection" "test" {
+  organization_id = %[1]q
+  name = %[2]q
+  project_ids = %[3]s
+}`, orgId, name, projectIds)
+}
@@ -0,0 +1,163 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &CollectionsDataSource{}
+
+func NewCollectionsDataSource() datasource.DataSource {
+	return &CollectionsDataSource{}
+}
+
+// CollectionsDataSource defines the data source implementation.
+type CollectionsDataSource struct {
+	client snykclient.Client
+}
+
+// CollectionsDataSourceModel describes the data source data model.
+type CollectionsDataSourceModel struct {
+	Id             types.String                           `tfsdk:"id"`
+	OrganizationId types.String                           `tfsdk:"organization_id"`
+	Collections    []CollectionsDataSourceCollectionModel `tfsdk:"collections"`
+}
+
+type CollectionsDataSourceCollectionModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	IsGenerated types.Bool   `tfsdk:"is_generated"`
+	ProjectIds  types.Set    `tfsdk:"project_ids"`
+}
+
+func (d *CollectionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collections"
+}
+
+func (d *CollectionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Provides the [collections](https://docs.snyk.io/snyk-admin/snyk-projects/project-collections-groupings/project-collections) of a Snyk organization and their projects",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as organization_id",
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+			},
+			"collections": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The collections of the organization",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Snyk Collection ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the collection",
+						},
+						"is_generated": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the collection is maintained by Snyk",
+						},
+						"project_ids": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "IDs of the projects of the collection",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CollectionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *CollectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CollectionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrganizationId.ValueString()
+
+	res, err := d.client.CollectionClient.ListCollections(ctx, orgID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Collections, got error: %s", err))
+		return
+	}
+
+	data.Id = data.OrganizationId
+	data.Collections = []CollectionsDataSourceCollectionModel{}
+	for _, c := range res {
+		ids, err := d.client.CollectionClient.ListProjects(ctx, orgID, c.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Collection %s projects, got error: %s", c.ID, err))
+			return
+		}
+
+		projectIds, diags := types.SetValueFrom(ctx, types.StringType, ids)
+		resp.Diagnostics.Append(diags...)
+
+		data.Collections = append(data.Collections, CollectionsDataSourceCollectionModel{
+			Id:          types.StringValue(c.ID),
+			Name:        types.StringValue(c.Name),
+			IsGenerated: types.BoolValue(c.IsGenerated),
+			ProjectIds:  projectIds,
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,329 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/role"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &CustomRoleResource{}
+var _ resource.ResourceWithImportState = &CustomRoleResource{}
+var _ resource.ResourceWithValidateConfig = &CustomRoleResource{}
+
+func NewCustomRoleResource() resource.Resource {
+	return &CustomRoleResource{}
+}
+
+// CustomRoleResource defines the resource implementation.
+type CustomRoleResource struct {
+	client snykclient.Client
+}
+
+// CustomRoleResourceModel describes the resource data model.
+type CustomRoleResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	GroupId     types.String `tfsdk:"group_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Scope       types.String `tfsdk:"scope"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+func (r *CustomRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_role"
+}
+
+func (r *CustomRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages a [custom member role](https://docs.snyk.io/snyk-admin/manage-users-and-permissions/member-roles) of a Snyk group, " +
+			"which can be given to the members and service accounts of the group or of its organizations.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Role ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Group GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the role",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the role",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Whether the role is given to members of organizations, `org`, or of the group, `group`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(role.SCOPE_ORG, role.SCOPE_GROUP),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "Permissions given by the role, such as `org.project.read`. Organization roles only take `org.` permissions, and group roles `group.` permissions.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomRoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CustomRoleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Scope.IsNull() || data.Scope.IsUnknown() {
+		return
+	}
+
+	catalog := map[string]bool{}
+	for _, permission := range role.Permissions(data.Scope.ValueString()) {
+		catalog[permission] = true
+	}
+
+	for _, element := range data.Permissions.Elements() {
+		permission, ok := element.(types.String)
+		if !ok || permission.IsNull() || permission.IsUnknown() {
+			continue
+		}
+
+		if !catalog[permission.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("permissions"),
+				"Invalid Permission",
+				fmt.Sprintf("%q is not a permission of %s roles, expected one of: %s", permission.ValueString(), data.Scope.ValueString(), strings.Join(role.Permissions(data.Scope.ValueString()), ", ")),
+			)
+		}
+	}
+}
+
+func (r *CustomRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// attributes returns the attributes of the role to send to the API.
+func (m *CustomRoleResourceModel) attributes(ctx context.Context) (role.RoleAttributes, diag.Diagnostics) {
+	attributes := role.RoleAttributes{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Scope:       m.Scope.ValueString(),
+		Permissions: []string{},
+	}
+	diags := stringSetElements(ctx, m.Permissions, &attributes.Permissions)
+	return attributes, diags
+}
+
+// setRole copies the attributes of the role returned by the API to the model.
+func (m *CustomRoleResourceModel) setRole(ctx context.Context, customRole *role.Role) diag.Diagnostics {
+	permissions, diags := types.SetValueFrom(ctx, types.StringType, customRole.Permissions)
+
+	m.Id = types.StringValue(customRole.ID)
+	m.Name = types.StringValue(customRole.Name)
+	m.Description = types.StringValue(customRole.Description)
+	m.Scope = types.StringValue(customRole.Scope)
+	m.Permissions = permissions
+
+	return diags
+}
+
+func (r *CustomRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *CustomRoleResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.GroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse CustomRole Group Guid, got error: %s", err))
+		return
+	}
+
+	attributes, diags := plan.attributes(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customRole, err := r.client.RoleClient.CreateRole(ctx, plan.GroupId.ValueString(), attributes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create CustomRole, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setRole(ctx, customRole)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CustomRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CustomRoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customRole, err := r.client.RoleClient.GetRole(ctx, data.GroupId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get CustomRole, got error: %s", err))
+		return
+	}
+
+	if customRole == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setRole(ctx, customRole)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *CustomRoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributes, diags := plan.attributes(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customRole, err := r.client.RoleClient.UpdateRole(ctx, plan.GroupId.ValueString(), plan.Id.ValueString(), attributes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update CustomRole, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setRole(ctx, customRole)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CustomRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CustomRoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RoleClient.DeleteRole(ctx, data.GroupId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete CustomRole, got error: %s", err))
+		return
+	}
+}
+
+func (r *CustomRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupId, roleId, found := strings.Cut(req.ID, "/")
+	if !found || groupId == "" || roleId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format group_id/role_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), roleId)...)
+}
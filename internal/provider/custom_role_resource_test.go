@@ -0,0 +1,82 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCustomRole(t *testing.T) {
+	snykGroupId := readEnvVarOrSkip(t, "TEST_SNYK_GROUP_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleCustomRoleResourceConfig(snykGroupId, "Terraform acceptance test", `"org.read", "group.read"`),
+				ExpectError: regexp.MustCompile(`"group.read" is not a permission of org roles`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleCustomRoleResourceConfig(snykGroupId, "Terraform acceptance test", `"org.read", "org.project.read"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snyk_custom_role.test", "id"),
+					resource.TestCheckResourceAttr("snyk_custom_role.test", "scope", "org"),
+					resource.TestCheckResourceAttr("snyk_custom_role.test", "permissions.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_custom_role.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccCustomRoleImportStateId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleCustomRoleResourceConfig(snykGroupId, "Terraform acceptance test, updated", `"org.read", "org.project.read", "org.project.test"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_custom_role.test", "name", "Terraform acceptance test, updated"),
+					resource.TestCheckResourceAttr("snyk_custom_role.test", "permissions.#", "3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCustomRoleImportStateId(s *terraform.State) (string, error) {
+	rs := s.RootModule().Resources["snyk_custom_role.test"]
+	return rs.Primary.Attributes["group_id"] + "/" + rs.Primary.ID, nil
+}
+
+func testAccExampleCustomRoleResourceConfig(groupId string, name string, permissions string) string {
+	return fmt.Sprintf(`
+resource "snyk_custom_role" "test" {
+  group_id = %[1]q
+  name = %[2]q
+  scope = "org"
+  permissions = [%[3]s]
+}`, groupId, name, permissions)
+}
@@ -0,0 +1,295 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/organization"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &IacSettingsResource{}
+var _ resource.ResourceWithImportState = &IacSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &IacSettingsResource{}
+
+func NewIacSettingsResource() resource.Resource {
+	return &IacSettingsResource{}
+}
+
+// IacSettingsResource defines the resource implementation.
+type IacSettingsResource struct {
+	client snykclient.Client
+}
+
+// IacSettingsResourceModel describes the resource data model.
+type IacSettingsResourceModel struct {
+	Id                        types.String `tfsdk:"id"`
+	OrganizationId            types.String `tfsdk:"organization_id"`
+	GroupId                   types.String `tfsdk:"group_id"`
+	CustomRulesEnabled        types.Bool   `tfsdk:"custom_rules_enabled"`
+	CustomRulesOciRegistryUrl types.String `tfsdk:"custom_rules_oci_registry_url"`
+	CustomRulesOciRegistryTag types.String `tfsdk:"custom_rules_oci_registry_tag"`
+	InheritFromGroup          types.Bool   `tfsdk:"inherit_from_group"`
+}
+
+// scope returns the API scope and the id of the organization or group.
+func (m *IacSettingsResourceModel) scope() (string, string) {
+	if !m.GroupId.IsNull() {
+		return organization.IAC_SCOPE_GROUP, m.GroupId.ValueString()
+	}
+	return organization.IAC_SCOPE_ORG, m.OrganizationId.ValueString()
+}
+
+func (r *IacSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iac_settings"
+}
+
+func (r *IacSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the [Infrastructure as Code settings](https://docs.snyk.io/scan-with-snyk/snyk-iac/current-iac-custom-rules) of a Snyk organization or group, " +
+			"depending on whether organization_id or group_id is set. Settings which are not configured keep their current value. Destroying this resource leaves the settings unchanged. " +
+			"Only the custom rules settings are managed: the IaC settings API does not expose settings for individual cloud environments.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as organization_id or group_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID. Conflicts with group_id.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("group_id")),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Group GUID",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_rules_enabled": optionalComputedBool("Test with the custom rules bundle"),
+			"custom_rules_oci_registry_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "URL of the OCI registry repository of the custom rules bundle, e.g. `https://registry-1.docker.io/account/bundle`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_rules_oci_registry_tag": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Tag of the custom rules bundle in the OCI registry, e.g. `latest`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"inherit_from_group": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Use the custom rules settings of the group of the organization, rather than its own. Only applies to organizations.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IacSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IacSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.GroupId.IsNull() && !data.InheritFromGroup.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("inherit_from_group"),
+			"Invalid Attribute Combination",
+			"inherit_from_group only applies to organizations, it cannot be set along with group_id",
+		)
+	}
+}
+
+func (r *IacSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+func (r *IacSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *IacSettingsResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateSettings(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// updateSettings sends the configured settings of plan, and fills plan with
+// the resulting settings.
+func (r *IacSettingsResource) updateSettings(ctx context.Context, plan *IacSettingsResourceModel) (diags diag.Diagnostics) {
+	scope, id := plan.scope()
+
+	update := &organization.IacSettingsUpdate{
+		IsEnabled:      boolPointer(plan.CustomRulesEnabled),
+		OciRegistryURL: stringPointer(plan.CustomRulesOciRegistryUrl),
+		OciRegistryTag: stringPointer(plan.CustomRulesOciRegistryTag),
+	}
+	if scope == organization.IAC_SCOPE_ORG {
+		update.InheritFromParent = boolPointer(plan.InheritFromGroup)
+	}
+
+	settings, err := r.client.OrgClient.UpdateIacSettings(ctx, scope, id, update)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update IacSettings, got error: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(id)
+	convertIacSettingsRemoteData2Local(plan, settings)
+	return
+}
+
+func convertIacSettingsRemoteData2Local(data *IacSettingsResourceModel, settings *organization.IacSettings) {
+	data.CustomRulesEnabled = types.BoolValue(settings.CustomRules.IsEnabled)
+	data.CustomRulesOciRegistryUrl = types.StringValue(settings.CustomRules.OciRegistryURL)
+	data.CustomRulesOciRegistryTag = types.StringValue(settings.CustomRules.OciRegistryTag)
+	if scope, _ := data.scope(); scope == organization.IAC_SCOPE_ORG {
+		data.InheritFromGroup = types.BoolValue(settings.CustomRules.InheritFromParent != "")
+	} else {
+		data.InheritFromGroup = types.BoolNull()
+	}
+}
+
+func (r *IacSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IacSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scope, id := data.scope()
+	settings, err := r.client.OrgClient.GetIacSettings(ctx, scope, id)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get IacSettings, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(id)
+	convertIacSettingsRemoteData2Local(data, settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IacSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *IacSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateSettings(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *IacSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Settings cannot be deleted, they are only removed from the state
+}
+
+func (r *IacSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scope, id, found := strings.Cut(req.ID, "/")
+	if !found || (scope != "organization" && scope != "group") || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format organization/organization_id or group/group_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(scope+"_id"), id)...)
+}
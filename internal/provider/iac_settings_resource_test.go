@@ -0,0 +1,70 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIacSettings(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleIacSettingsResourceConfig(snykOrgId, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_iac_settings.test", "id", snykOrgId),
+					resource.TestCheckResourceAttr("snyk_iac_settings.test", "custom_rules_enabled", "true"),
+					resource.TestCheckResourceAttr("snyk_iac_settings.test", "custom_rules_oci_registry_tag", "v1"),
+					resource.TestCheckResourceAttr("snyk_iac_settings.test", "inherit_from_group", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_iac_settings.test",
+				ImportState:       true,
+				ImportStateId:     "organization/" + snykOrgId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleIacSettingsResourceConfig(snykOrgId, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_iac_settings.test", "custom_rules_oci_registry_tag", "v2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExampleIacSettingsResourceConfig(orgId string, tag string) string {
+	return fmt.Sprintf(`
+resource "snyk_iac_settings" "test" {
+  organization_id = %[1]q
+  custom_rules_enabled = true
+  custom_rules_oci_registry_url = "https://registry-1.docker.io/snyk/custom-rules"
+  custom_rules_oci_registry_tag = %[2]q
+  inherit_from_group = false
+}`, orgId, tag)
+}
@@ -0,0 +1,345 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/project"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &IgnoreResource{}
+var _ resource.ResourceWithImportState = &IgnoreResource{}
+var _ resource.ResourceWithValidateConfig = &IgnoreResource{}
+
+func NewIgnoreResource() resource.Resource {
+	return &IgnoreResource{}
+}
+
+// IgnoreResource defines the resource implementation.
+type IgnoreResource struct {
+	client snykclient.Client
+}
+
+// IgnoreResourceModel describes the resource data model.
+type IgnoreResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	OrganizationId     types.String `tfsdk:"organization_id"`
+	ProjectId          types.String `tfsdk:"project_id"`
+	IssueId            types.String `tfsdk:"issue_id"`
+	Path               types.String `tfsdk:"path"`
+	Reason             types.String `tfsdk:"reason"`
+	ReasonType         types.String `tfsdk:"reason_type"`
+	Expires            types.String `tfsdk:"expires"`
+	DisregardIfFixable types.Bool   `tfsdk:"disregard_if_fixable"`
+}
+
+func (r *IgnoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ignore"
+}
+
+func (r *IgnoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the [ignore](https://docs.snyk.io/manage-risk/prioritize-issues-for-fixing/ignore-issues) of an issue of a Snyk project, for one dependency path or for all of them. " +
+			"Ignores removed outside of Terraform are created again. An expired ignore is kept, with a warning, until its expiry is changed or it is destroyed; " +
+			"creating an ignore which has already expired is an error.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as the import identifier, organization_id/project_id/issue_id/path",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the issue, such as `SNYK-JS-LODASH-567746`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Dependency path the ignore applies to, such as `express@4.17.1 > lodash@4.17.15`. Defaults to `*`, all the paths.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(project.IGNORE_ALL_PATHS),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Reason of the ignore",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"reason_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the reason: " + strings.Join(project.IgnoreReasonTypes, ", "),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(project.IgnoreReasonTypes...),
+				},
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: "Expiry of the ignore, as an RFC 3339 timestamp such as `2024-12-31T00:00:00Z`. The ignore does not expire when unset.",
+				Optional:            true,
+			},
+			"disregard_if_fixable": schema.BoolAttribute{
+				MarkdownDescription: "Whether the ignore only applies while the issue cannot be fixed",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *IgnoreResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var expires types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expires"), &expires)...)
+
+	if resp.Diagnostics.HasError() || expires.IsNull() || expires.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, expires.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expires"), "Invalid Attribute Value", fmt.Sprintf("expires must be an RFC 3339 timestamp, got error: %s", err))
+		return
+	}
+
+	if ignoreExpired(expires.ValueString()) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("expires"), "Expired Ignore", fmt.Sprintf("The ignore expired at %s and no longer applies", expires.ValueString()))
+	}
+}
+
+func (r *IgnoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// ignore returns the ignore to send to the API.
+func (m *IgnoreResourceModel) ignore() project.Ignore {
+	return project.Ignore{
+		Path:               m.Path.ValueString(),
+		Reason:             m.Reason.ValueString(),
+		ReasonType:         m.ReasonType.ValueString(),
+		DisregardIfFixable: m.DisregardIfFixable.ValueBool(),
+		Expires:            m.Expires.ValueString(),
+	}
+}
+
+// ignoreExpired returns whether the ignore expired, and so no longer applies.
+func ignoreExpired(expires string) bool {
+	if expires == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, expires)
+	return err == nil && t.Before(time.Now())
+}
+
+// sameTime returns whether a and b are the same timestamp, even if formatted
+// differently, as the API adds milliseconds to the expiries.
+func sameTime(a string, b string) bool {
+	ta, errA := time.Parse(time.RFC3339, a)
+	tb, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return ta.Equal(tb)
+}
+
+func (r *IgnoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *IgnoreResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse Ignore Organization Guid, got error: %s", err))
+		return
+	}
+
+	// Creating an expired ignore would create it again on every apply.
+	if ignoreExpired(plan.Expires.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("expires"), "Expired Ignore", fmt.Sprintf("Unable to create Ignore, it expired at %s", plan.Expires.ValueString()))
+		return
+	}
+
+	err = r.client.ProjectClient.AddIgnore(ctx, plan.OrganizationId.ValueString(), plan.ProjectId.ValueString(), plan.IssueId.ValueString(), plan.ignore())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Ignore, got error: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(strings.Join([]string{
+		plan.OrganizationId.ValueString(), plan.ProjectId.ValueString(), plan.IssueId.ValueString(), plan.Path.ValueString(),
+	}, "/"))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *IgnoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IgnoreResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ignore, err := r.client.ProjectClient.GetIgnore(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.IssueId.ValueString(), data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Ignore, got error: %s", err))
+		return
+	}
+
+	// The ignore was removed. An expired ignore is kept, so that it is not
+	// created again.
+	if ignore == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Reason = types.StringValue(ignore.Reason)
+	data.ReasonType = types.StringValue(ignore.ReasonType)
+	data.DisregardIfFixable = types.BoolValue(ignore.DisregardIfFixable)
+	if ignore.Expires == "" {
+		data.Expires = types.StringNull()
+	} else if !sameTime(ignore.Expires, data.Expires.ValueString()) {
+		data.Expires = types.StringValue(ignore.Expires)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IgnoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *IgnoreResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ProjectClient.UpdateIgnore(ctx, plan.OrganizationId.ValueString(), plan.ProjectId.ValueString(), plan.IssueId.ValueString(), plan.ignore())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Ignore, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *IgnoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IgnoreResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ProjectClient.DeleteIgnore(ctx, data.OrganizationId.ValueString(), data.ProjectId.ValueString(), data.IssueId.ValueString(), data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Ignore, got error: %s", err))
+		return
+	}
+}
+
+func (r *IgnoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The path comes last, as it may contain slashes, as in @scope/package@1.0.0.
+	parts := strings.SplitN(req.ID, "/", 4)
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" || (len(parts) == 4 && parts[3] == "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format organization_id/project_id/issue_id or organization_id/project_id/issue_id/path, got: %q", req.ID),
+		)
+		return
+	}
+
+	ignorePath := project.IGNORE_ALL_PATHS
+	if len(parts) == 4 {
+		ignorePath = parts[3]
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join([]string{parts[0], parts[1], parts[2], ignorePath}, "/"))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issue_id"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), ignorePath)...)
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIgnore(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	projectId := readEnvVarOrSkip(t, "TEST_SNYK_PROJECT_ID")
+	issueId := readEnvVarOrSkip(t, "TEST_SNYK_ISSUE_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleIgnoreResourceConfig(snykOrgId, projectId, issueId, "Terraform acceptance test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_ignore.test", "id", snykOrgId+"/"+projectId+"/"+issueId+"/*"),
+					resource.TestCheckResourceAttr("snyk_ignore.test", "path", "*"),
+					resource.TestCheckResourceAttr("snyk_ignore.test", "reason_type", "wont-fix"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_ignore.test",
+				ImportState:       true,
+				ImportStateId:     snykOrgId + "/" + projectId + "/" + issueId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleIgnoreResourceConfig(snykOrgId, projectId, issueId, "Terraform acceptance test, updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_ignore.test", "reason", "Terraform acceptance test, updated"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIgnoreExpired(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	projectId := readEnvVarOrSkip(t, "TEST_SNYK_PROJECT_ID")
+	issueId := readEnvVarOrSkip(t, "TEST_SNYK_ISSUE_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleIgnoreResourceConfigWithExpiry(snykOrgId, projectId, issueId, "Terraform acceptance test", "2000-01-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile("Expired Ignore"),
+			},
+		},
+	})
+}
+
+func testAccExampleIgnoreResourceConfig(orgId string, projectId string, issueId string, reason string) string {
+	return testAccExampleIgnoreResourceConfigWithExpiry(orgId, projectId, issueId, reason, "2099-01-01T00:00:00Z")
+}
+
+func testAccExampleIgnoreResourceConfigWithExpiry(orgId string, projectId string, issueId string, reason string, expires string) string {
+	return fmt.Sprintf(`
+resource "snyk_ignore" "test" {
+  organization_id = %[1]q
+  project_id = %[2]q
+  issue_id = %[3]q
+  reason = %[4]q
+  reason_type = "wont-fix"
+  expires = %[5]q
+}`, orgId, projectId, issueId, reason, expires)
+}
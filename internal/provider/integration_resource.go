@@ -0,0 +1,528 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/integration"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &IntegrationResource{}
+var _ resource.ResourceWithImportState = &IntegrationResource{}
+
+func NewIntegrationResource() resource.Resource {
+	return &IntegrationResource{}
+}
+
+// IntegrationResource defines the resource implementation.
+type IntegrationResource struct {
+	client snykclient.Client
+}
+
+// IntegrationResourceModel describes the resource data model.
+type IntegrationResourceModel struct {
+	Id               types.String                                    `tfsdk:"id"`
+	OrganizationId   types.String                                    `tfsdk:"organization_id"`
+	Type             types.String                                    `tfsdk:"type"`
+	Github           *IntegrationGithubConfigResourceModel           `tfsdk:"github"`
+	GithubEnterprise *IntegrationGithubEnterpriseConfigResourceModel `tfsdk:"github_enterprise"`
+	Gitlab           *IntegrationGitlabConfigResourceModel           `tfsdk:"gitlab"`
+	BitbucketCloud   *IntegrationBitbucketCloudConfigResourceModel   `tfsdk:"bitbucket_cloud"`
+	BitbucketServer  *IntegrationBitbucketServerConfigResourceModel  `tfsdk:"bitbucket_server"`
+	AzureRepos       *IntegrationAzureReposConfigResourceModel       `tfsdk:"azure_repos"`
+	Ecr              *IntegrationEcrConfigResourceModel              `tfsdk:"ecr"`
+	Acr              *IntegrationAcrConfigResourceModel              `tfsdk:"acr"`
+	Gcr              *IntegrationGcrConfigResourceModel              `tfsdk:"gcr"`
+	DockerHub        *IntegrationDockerHubConfigResourceModel        `tfsdk:"docker_hub"`
+}
+
+type IntegrationGithubConfigResourceModel struct {
+	Token types.String `tfsdk:"token"`
+}
+
+type IntegrationGithubEnterpriseConfigResourceModel struct {
+	Token types.String `tfsdk:"token"`
+	Url   types.String `tfsdk:"url"`
+}
+
+type IntegrationGitlabConfigResourceModel struct {
+	Token types.String `tfsdk:"token"`
+	Url   types.String `tfsdk:"url"`
+}
+
+type IntegrationBitbucketCloudConfigResourceModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+type IntegrationBitbucketServerConfigResourceModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Url      types.String `tfsdk:"url"`
+}
+
+type IntegrationAzureReposConfigResourceModel struct {
+	Token types.String `tfsdk:"token"`
+	Url   types.String `tfsdk:"url"`
+}
+
+type IntegrationEcrConfigResourceModel struct {
+	Region  types.String `tfsdk:"region"`
+	RoleArn types.String `tfsdk:"role_arn"`
+}
+
+type IntegrationAcrConfigResourceModel struct {
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	RegistryBase types.String `tfsdk:"registry_base"`
+}
+
+type IntegrationGcrConfigResourceModel struct {
+	Password     types.String `tfsdk:"password"`
+	RegistryBase types.String `tfsdk:"registry_base"`
+}
+
+type IntegrationDockerHubConfigResourceModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+func (r *IntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (r *IntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Provides Snyk [SCM and container registry integrations](https://docs.snyk.io/integrate-with-snyk). " +
+			"An organization has at most one integration of each type. The API does not allow deleting an integration, " +
+			"so destroying this resource removes its credentials.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Snyk Integration ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("One of [%s]", strings.Join(integration.Types, ",")),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(integration.Types...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"github": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"token": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "GitHub personal access token",
+					},
+				},
+			},
+			"github_enterprise": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"token": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "GitHub Enterprise personal access token",
+					}, "url": schema.StringAttribute{
+						Optional:    true,
+						Description: "URL of the GitHub Enterprise instance",
+					},
+				},
+			},
+			"gitlab": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"token": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "GitLab personal access token",
+					}, "url": schema.StringAttribute{
+						Optional:    true,
+						Description: "URL of a self-hosted GitLab instance",
+					},
+				},
+			},
+			"bitbucket_cloud": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Optional:    true,
+						Description: "Bitbucket username",
+					}, "password": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Bitbucket app password",
+					},
+				},
+			},
+			"bitbucket_server": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Optional:    true,
+						Description: "Bitbucket Server username",
+					}, "password": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Bitbucket Server password or personal access token",
+					}, "url": schema.StringAttribute{
+						Optional:    true,
+						Description: "URL of the Bitbucket Server instance",
+					},
+				},
+			},
+			"azure_repos": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"token": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Azure DevOps personal access token",
+					}, "url": schema.StringAttribute{
+						Optional:    true,
+						Description: "URL of the Azure DevOps organization",
+					},
+				},
+			},
+			"ecr": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Optional:    true,
+						Description: "AWS region of the registry",
+					}, "role_arn": schema.StringAttribute{
+						Optional:    true,
+						Description: "ARN of the AWS role Snyk assumes to pull images",
+					},
+				},
+			},
+			"acr": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Optional:    true,
+						Description: "Azure service principal or admin user name",
+					}, "password": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Azure service principal or admin user password",
+					}, "registry_base": schema.StringAttribute{
+						Optional:    true,
+						Description: "Host name of the registry, e.g. myregistry.azurecr.io",
+					},
+				},
+			},
+			"gcr": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"password": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "JSON key file of the Google service account",
+					}, "registry_base": schema.StringAttribute{
+						Optional:    true,
+						Description: "Host name of the registry, e.g. gcr.io",
+					},
+				},
+			},
+			"docker_hub": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Optional:    true,
+						Description: "Docker Hub username",
+					}, "password": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Docker Hub access token",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *IntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *IntegrationResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse Integration Organization Guid, got error: %s", err))
+		return
+	}
+
+	integrationType := plan.Type.ValueString()
+	request, typeDiags := r.prepareIntegrationRequest(integrationType, plan)
+	resp.Diagnostics.Append(typeDiags...)
+	if typeDiags.HasError() {
+		return
+	}
+
+	res, err := r.client.IntegrationClient.CreateIntegration(ctx, plan.OrganizationId.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Integration, got error: %s", err))
+		return
+	} else {
+		plan.Id = types.StringValue(res.ID)
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// prepareIntegrationRequest builds the request for the given integration type,
+// checking that only the block of that type is set and that its required
+// credentials are present.
+func (r *IntegrationResource) prepareIntegrationRequest(integrationType string, plan *IntegrationResourceModel) (request *integration.IntegrationRequest, diags diag.Diagnostics) {
+	blocks := map[string]bool{
+		integration.TYPE_GITHUB:            plan.Github != nil,
+		integration.TYPE_GITHUB_ENTERPRISE: plan.GithubEnterprise != nil,
+		integration.TYPE_GITLAB:            plan.Gitlab != nil,
+		integration.TYPE_BITBUCKET_CLOUD:   plan.BitbucketCloud != nil,
+		integration.TYPE_BITBUCKET_SERVER:  plan.BitbucketServer != nil,
+		integration.TYPE_AZURE_REPOS:       plan.AzureRepos != nil,
+		integration.TYPE_ECR:               plan.Ecr != nil,
+		integration.TYPE_ACR:               plan.Acr != nil,
+		integration.TYPE_GCR:               plan.Gcr != nil,
+		integration.TYPE_DOCKER_HUB:        plan.DockerHub != nil,
+	}
+
+	block := integrationBlockName(integrationType)
+	if !blocks[integrationType] {
+		diags.AddError("Configuration Error", fmt.Sprintf("Invalid configuration, a %s block should be provided when using %s", block, integrationType))
+		return
+	}
+	for otherType, set := range blocks {
+		if set && otherType != integrationType {
+			diags.AddError("Configuration Error", fmt.Sprintf("Invalid configuration, only the %s block should be provided when using %s", block, integrationType))
+			return
+		}
+	}
+
+	credentials := &integration.Credentials{}
+	required := map[string]types.String{}
+
+	switch integrationType {
+	case integration.TYPE_GITHUB:
+		credentials.Token = plan.Github.Token.ValueString()
+		required["token"] = plan.Github.Token
+	case integration.TYPE_GITHUB_ENTERPRISE:
+		credentials.Token = plan.GithubEnterprise.Token.ValueString()
+		credentials.URL = plan.GithubEnterprise.Url.ValueString()
+		required["token"] = plan.GithubEnterprise.Token
+		required["url"] = plan.GithubEnterprise.Url
+	case integration.TYPE_GITLAB:
+		credentials.Token = plan.Gitlab.Token.ValueString()
+		credentials.URL = plan.Gitlab.Url.ValueString()
+		required["token"] = plan.Gitlab.Token
+	case integration.TYPE_BITBUCKET_CLOUD:
+		credentials.Username = plan.BitbucketCloud.Username.ValueString()
+		credentials.Password = plan.BitbucketCloud.Password.ValueString()
+		required["username"] = plan.BitbucketCloud.Username
+		required["password"] = plan.BitbucketCloud.Password
+	case integration.TYPE_BITBUCKET_SERVER:
+		credentials.Username = plan.BitbucketServer.Username.ValueString()
+		credentials.Password = plan.BitbucketServer.Password.ValueString()
+		credentials.URL = plan.BitbucketServer.Url.ValueString()
+		required["username"] = plan.BitbucketServer.Username
+		required["password"] = plan.BitbucketServer.Password
+		required["url"] = plan.BitbucketServer.Url
+	case integration.TYPE_AZURE_REPOS:
+		credentials.Token = plan.AzureRepos.Token.ValueString()
+		credentials.URL = plan.AzureRepos.Url.ValueString()
+		required["token"] = plan.AzureRepos.Token
+		required["url"] = plan.AzureRepos.Url
+	case integration.TYPE_ECR:
+		credentials.Region = plan.Ecr.Region.ValueString()
+		credentials.RoleArn = plan.Ecr.RoleArn.ValueString()
+		required["region"] = plan.Ecr.Region
+		required["role_arn"] = plan.Ecr.RoleArn
+	case integration.TYPE_ACR:
+		credentials.Username = plan.Acr.Username.ValueString()
+		credentials.Password = plan.Acr.Password.ValueString()
+		credentials.RegistryBase = plan.Acr.RegistryBase.ValueString()
+		required["username"] = plan.Acr.Username
+		required["password"] = plan.Acr.Password
+		required["registry_base"] = plan.Acr.RegistryBase
+	case integration.TYPE_GCR:
+		credentials.Password = plan.Gcr.Password.ValueString()
+		credentials.RegistryBase = plan.Gcr.RegistryBase.ValueString()
+		required["password"] = plan.Gcr.Password
+		required["registry_base"] = plan.Gcr.RegistryBase
+	case integration.TYPE_DOCKER_HUB:
+		credentials.Username = plan.DockerHub.Username.ValueString()
+		credentials.Password = plan.DockerHub.Password.ValueString()
+		required["username"] = plan.DockerHub.Username
+		required["password"] = plan.DockerHub.Password
+	}
+
+	for name, value := range required {
+		if strings.TrimSpace(value.ValueString()) == "" {
+			diags.AddError("Configuration Error", fmt.Sprintf("Unable to read %s %s. A valid %s should be provided.", block, name, name))
+		}
+	}
+	if diags.HasError() {
+		return
+	}
+
+	return &integration.IntegrationRequest{Type: integrationType, Credentials: credentials}, diags
+}
+
+// integrationBlockName returns the name of the block holding the credentials
+// of an integration type.
+func integrationBlockName(integrationType string) string {
+	return strings.ReplaceAll(integrationType, "-", "_")
+}
+
+func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IntegrationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The credentials cannot be read back, only the integration itself.
+	res, err := r.client.IntegrationClient.GetIntegration(ctx, data.OrganizationId.ValueString(), data.Type.ValueString())
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Integration, got error: %s", err))
+		return
+	}
+	data.Id = types.StringValue(res.ID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *IntegrationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, typeDiags := r.prepareIntegrationRequest(plan.Type.ValueString(), plan)
+	resp.Diagnostics.Append(typeDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.IntegrationClient.UpdateIntegration(ctx, plan.OrganizationId.ValueString(), plan.Id.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Integration, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IntegrationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.IntegrationClient.DeleteIntegration(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not delete Integration, got error: %s", err))
+		return
+	}
+}
+
+func (r *IntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Integrations are looked up by type, so the id alone is not enough.
+	organizationId, integrationType, found := strings.Cut(req.ID, "/")
+	if !found || organizationId == "" || integrationType == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format organization_id/type, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), integrationType)...)
+}
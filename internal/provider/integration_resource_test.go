@@ -0,0 +1,69 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGitlabIntegration(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	gitlabToken := readEnvVarOrSkip(t, "TEST_GITLAB_TOKEN")
+	gitlabRotatedToken := readEnvVarOrSkip(t, "TEST_GITLAB_ROTATED_TOKEN")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForGitlab(snykOrgId, gitlabToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_integration.test", "type", "gitlab"),
+					resource.TestCheckResourceAttr("snyk_integration.test", "organization_id", snykOrgId),
+					resource.TestCheckResourceAttrSet("snyk_integration.test", "id"),
+					resource.TestCheckResourceAttrPair("data.snyk_integrations.test", "integrations.gitlab", "snyk_integration.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForGitlab(snykOrgId, gitlabRotatedToken),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_integration.test", "gitlab.token", gitlabRotatedToken),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExampleResourceConfigForGitlab(orgId string, token string) string {
+	return fmt.Sprintf(`
+resource "snyk_integration" "test" {
+  organization_id = %[1]q
+  type = "gitlab"
+  gitlab {
+    token = %[2]q
+  }
+}
+
+data "snyk_integrations" "test" {
+  organization_id = snyk_integration.test.organization_id
+}`, orgId, token)
+}
@@ -0,0 +1,432 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/integration"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &IntegrationSettingsResource{}
+var _ resource.ResourceWithImportState = &IntegrationSettingsResource{}
+
+func NewIntegrationSettingsResource() resource.Resource {
+	return &IntegrationSettingsResource{}
+}
+
+// IntegrationSettingsResource defines the resource implementation.
+type IntegrationSettingsResource struct {
+	client snykclient.Client
+}
+
+// IntegrationSettingsResourceModel describes the resource data model.
+type IntegrationSettingsResourceModel struct {
+	Id                                 types.String                                   `tfsdk:"id"`
+	OrganizationId                     types.String                                   `tfsdk:"organization_id"`
+	IntegrationId                      types.String                                   `tfsdk:"integration_id"`
+	PullRequestTestEnabled             types.Bool                                     `tfsdk:"pull_request_test_enabled"`
+	PullRequestFailOnAnyVulns          types.Bool                                     `tfsdk:"pull_request_fail_on_any_vulns"`
+	PullRequestFailOnlyForHighSeverity types.Bool                                     `tfsdk:"pull_request_fail_only_for_high_severity"`
+	AutoDepUpgradeEnabled              types.Bool                                     `tfsdk:"auto_dep_upgrade_enabled"`
+	AutoDepUpgradeLimit                types.Int64                                    `tfsdk:"auto_dep_upgrade_limit"`
+	AutoDepUpgradeMinAge               types.Int64                                    `tfsdk:"auto_dep_upgrade_min_age"`
+	AutoDepUpgradeIgnoredDependencies  types.Set                                      `tfsdk:"auto_dep_upgrade_ignored_dependencies"`
+	PullRequestAssignment              *IntegrationSettingsPullRequestAssignmentModel `tfsdk:"pull_request_assignment"`
+	AutoRemediationPrs                 *IntegrationSettingsAutoRemediationPrsModel    `tfsdk:"auto_remediation_prs"`
+}
+
+type IntegrationSettingsPullRequestAssignmentModel struct {
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Type      types.String `tfsdk:"type"`
+	Assignees types.Set    `tfsdk:"assignees"`
+}
+
+type IntegrationSettingsAutoRemediationPrsModel struct {
+	FreshPrsEnabled     types.Bool   `tfsdk:"fresh_prs_enabled"`
+	BacklogPrsEnabled   types.Bool   `tfsdk:"backlog_prs_enabled"`
+	BacklogPrStrategy   types.String `tfsdk:"backlog_pr_strategy"`
+	UsePatchRemediation types.Bool   `tfsdk:"use_patch_remediation"`
+}
+
+func (r *IntegrationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_settings"
+}
+
+// optionalComputedBool is a setting which keeps its remote value when it is
+// not configured.
+func optionalComputedBool(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *IntegrationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Provides the pull request and dependency upgrade [settings](https://docs.snyk.io/scan-with-snyk/pull-requests) of a Snyk SCM integration. " +
+			"Settings which are not configured keep their current value. Destroying this resource leaves the settings unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as integration_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Integration ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pull_request_test_enabled":                optionalComputedBool("Test the pull requests of the repositories of the integration"),
+			"pull_request_fail_on_any_vulns":           optionalComputedBool("Fail the pull request checks on any vulnerability, rather than only on the ones introduced by the pull request"),
+			"pull_request_fail_only_for_high_severity": optionalComputedBool("Only fail the pull request checks for high and critical severity issues"),
+			"auto_dep_upgrade_enabled":                 optionalComputedBool("Open pull requests to upgrade outdated dependencies"),
+			"auto_dep_upgrade_limit": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Maximum number of open dependency upgrade pull requests per project. Constraints: Min 1|Max 10",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+			"auto_dep_upgrade_min_age": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Minimum age, in days, of a version before a dependency is upgraded to it",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"auto_dep_upgrade_ignored_dependencies": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Dependencies which are never upgraded automatically",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"pull_request_assignment": schema.SingleNestedBlock{
+				MarkdownDescription: "Assignment of the pull requests opened by Snyk",
+				Attributes: map[string]schema.Attribute{
+					"enabled": optionalComputedBool("Assign the pull requests opened by Snyk"),
+					"type": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "One of [auto,manual]. auto assigns the last committer, manual the assignees.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf("auto", "manual"),
+						},
+					},
+					"assignees": schema.SetAttribute{
+						Optional:            true,
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "SCM usernames to assign when type is manual",
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"auto_remediation_prs": schema.SingleNestedBlock{
+				MarkdownDescription: "Pull requests opened automatically to fix vulnerabilities",
+				Attributes: map[string]schema.Attribute{
+					"fresh_prs_enabled":     optionalComputedBool("Open fix pull requests for newly found vulnerabilities"),
+					"backlog_prs_enabled":   optionalComputedBool("Open fix pull requests for existing vulnerabilities"),
+					"use_patch_remediation": optionalComputedBool("Include Snyk patches in the fix pull requests"),
+					"backlog_pr_strategy": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "One of [vuln,dependency]",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf("vuln", "dependency"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *IntegrationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+func (r *IntegrationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *IntegrationSettingsResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse IntegrationSettings Organization Guid, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateSettings(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// updateSettings sends the configured settings of plan, and fills plan with
+// the resulting settings.
+func (r *IntegrationSettingsResource) updateSettings(ctx context.Context, plan *IntegrationSettingsResourceModel) (diags diag.Diagnostics) {
+	request, requestDiags := r.prepareSettingsRequest(ctx, plan)
+	diags.Append(requestDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	res, err := r.client.IntegrationClient.UpdateIntegrationSettings(ctx, plan.OrganizationId.ValueString(), plan.IntegrationId.ValueString(), request)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update IntegrationSettings, got error: %s", err))
+		return
+	}
+
+	plan.Id = plan.IntegrationId
+	diags.Append(r.convertRemoteData2Local(ctx, plan, res)...)
+	return
+}
+
+func (r *IntegrationSettingsResource) prepareSettingsRequest(ctx context.Context, plan *IntegrationSettingsResourceModel) (*integration.Settings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := &integration.Settings{
+		PullRequestTestEnabled:             boolPointer(plan.PullRequestTestEnabled),
+		PullRequestFailOnAnyVulns:          boolPointer(plan.PullRequestFailOnAnyVulns),
+		PullRequestFailOnlyForHighSeverity: boolPointer(plan.PullRequestFailOnlyForHighSeverity),
+		AutoDepUpgradeEnabled:              boolPointer(plan.AutoDepUpgradeEnabled),
+		AutoDepUpgradeLimit:                int64Pointer(plan.AutoDepUpgradeLimit),
+		AutoDepUpgradeMinAge:               int64Pointer(plan.AutoDepUpgradeMinAge),
+	}
+
+	ignoredDependencies, setDiags := stringSetPointer(ctx, plan.AutoDepUpgradeIgnoredDependencies)
+	diags.Append(setDiags...)
+	request.AutoDepUpgradeIgnoredDependencies = ignoredDependencies
+
+	if plan.PullRequestAssignment != nil {
+		assignees, setDiags := stringSetPointer(ctx, plan.PullRequestAssignment.Assignees)
+		diags.Append(setDiags...)
+		request.PullRequestAssignment = &integration.PullRequestAssignment{
+			Enabled:   boolPointer(plan.PullRequestAssignment.Enabled),
+			Type:      stringPointer(plan.PullRequestAssignment.Type),
+			Assignees: assignees,
+		}
+	}
+
+	if plan.AutoRemediationPrs != nil {
+		request.AutoRemediationPrs = &integration.AutoRemediationPrs{
+			FreshPrsEnabled:     boolPointer(plan.AutoRemediationPrs.FreshPrsEnabled),
+			BacklogPrsEnabled:   boolPointer(plan.AutoRemediationPrs.BacklogPrsEnabled),
+			BacklogPrStrategy:   stringPointer(plan.AutoRemediationPrs.BacklogPrStrategy),
+			UsePatchRemediation: boolPointer(plan.AutoRemediationPrs.UsePatchRemediation),
+		}
+	}
+
+	return request, diags
+}
+
+// convertRemoteData2Local copies the remote settings into data. The nested
+// blocks are only refreshed when they are configured, since Terraform does
+// not allow blocks to be computed.
+func (r *IntegrationSettingsResource) convertRemoteData2Local(ctx context.Context, data *IntegrationSettingsResourceModel, res *integration.Settings) (diags diag.Diagnostics) {
+	data.PullRequestTestEnabled = types.BoolPointerValue(res.PullRequestTestEnabled)
+	data.PullRequestFailOnAnyVulns = types.BoolPointerValue(res.PullRequestFailOnAnyVulns)
+	data.PullRequestFailOnlyForHighSeverity = types.BoolPointerValue(res.PullRequestFailOnlyForHighSeverity)
+	data.AutoDepUpgradeEnabled = types.BoolPointerValue(res.AutoDepUpgradeEnabled)
+	data.AutoDepUpgradeLimit = types.Int64PointerValue(res.AutoDepUpgradeLimit)
+	data.AutoDepUpgradeMinAge = types.Int64PointerValue(res.AutoDepUpgradeMinAge)
+
+	ignoredDependencies, setDiags := stringSetPointerValue(ctx, res.AutoDepUpgradeIgnoredDependencies)
+	diags.Append(setDiags...)
+	data.AutoDepUpgradeIgnoredDependencies = ignoredDependencies
+
+	if data.PullRequestAssignment != nil {
+		assignment := res.PullRequestAssignment
+		if assignment == nil {
+			assignment = &integration.PullRequestAssignment{}
+		}
+		assignees, setDiags := stringSetPointerValue(ctx, assignment.Assignees)
+		diags.Append(setDiags...)
+		data.PullRequestAssignment = &IntegrationSettingsPullRequestAssignmentModel{
+			Enabled:   types.BoolPointerValue(assignment.Enabled),
+			Type:      types.StringPointerValue(assignment.Type),
+			Assignees: assignees,
+		}
+	}
+
+	if data.AutoRemediationPrs != nil {
+		remediation := res.AutoRemediationPrs
+		if remediation == nil {
+			remediation = &integration.AutoRemediationPrs{}
+		}
+		data.AutoRemediationPrs = &IntegrationSettingsAutoRemediationPrsModel{
+			FreshPrsEnabled:     types.BoolPointerValue(remediation.FreshPrsEnabled),
+			BacklogPrsEnabled:   types.BoolPointerValue(remediation.BacklogPrsEnabled),
+			BacklogPrStrategy:   types.StringPointerValue(remediation.BacklogPrStrategy),
+			UsePatchRemediation: types.BoolPointerValue(remediation.UsePatchRemediation),
+		}
+	}
+
+	return
+}
+
+func (r *IntegrationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IntegrationSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.IntegrationClient.GetIntegrationSettings(ctx, data.OrganizationId.ValueString(), data.IntegrationId.ValueString())
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get IntegrationSettings, got error: %s", err))
+		return
+	}
+
+	data.Id = data.IntegrationId
+	resp.Diagnostics.Append(r.convertRemoteData2Local(ctx, data, res)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *IntegrationSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateSettings(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *IntegrationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Settings cannot be deleted, they are only removed from the state
+}
+
+func (r *IntegrationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, integrationId, found := strings.Cut(req.ID, "/")
+	if !found || organizationId == "" || integrationId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format organization_id/integration_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_id"), integrationId)...)
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIntegrationSettings(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	integrationId := readEnvVarOrSkip(t, "TEST_SNYK_INTEGRATION_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForIntegrationSettings(snykOrgId, integrationId, true, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_integration_settings.test", "id", integrationId),
+					resource.TestCheckResourceAttr("snyk_integration_settings.test", "pull_request_test_enabled", "true"),
+					resource.TestCheckResourceAttr("snyk_integration_settings.test", "auto_dep_upgrade_limit", "2"),
+					resource.TestCheckResourceAttr("snyk_integration_settings.test", "pull_request_assignment.type", "auto"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "snyk_integration_settings.test",
+				ImportState:             true,
+				ImportStateId:           snykOrgId + "/" + integrationId,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pull_request_assignment"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForIntegrationSettings(snykOrgId, integrationId, false, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_integration_settings.test", "pull_request_test_enabled", "false"),
+					resource.TestCheckResourceAttr("snyk_integration_settings.test", "auto_dep_upgrade_limit", "3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExampleResourceConfigForIntegrationSettings(orgId string, integrationId string, testEnabled bool, limit int) string {
+	return fmt.Sprintf(`
+resource "snyk_integration_settings" "test" {
+  organization_id = %[1]q
+  integration_id = %[2]q
+  pull_request_test_enabled = %[3]t
+  auto_dep_upgrade_limit = %[4]d
+  pull_request_assignment {
+    enabled = true
+    type = "auto"
+  }
+}`, orgId, integrationId, testEnabled, limit)
+}
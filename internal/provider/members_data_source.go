@@ -0,0 +1,172 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/membership"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &MembersDataSource{}
+
+func NewOrganizationMembersDataSource() datasource.DataSource {
+	return &MembersDataSource{scope: membership.SCOPE_ORG, name: "organization", idAttribute: "organization_id", description: "Snyk Organization GUID"}
+}
+
+func NewGroupMembersDataSource() datasource.DataSource {
+	return &MembersDataSource{scope: membership.SCOPE_GROUP, name: "group", idAttribute: "group_id", description: "Snyk Group GUID"}
+}
+
+// MembersDataSource defines the data source implementation, for the
+// members of either organizations or groups.
+type MembersDataSource struct {
+	client      snykclient.Client
+	scope       string
+	name        string
+	idAttribute string
+	description string
+}
+
+// MembersDataSourceMemberModel describes a member in the data source data
+// model.
+type MembersDataSourceMemberModel struct {
+	Id       types.String `tfsdk:"id"`
+	UserId   types.String `tfsdk:"user_id"`
+	Name     types.String `tfsdk:"name"`
+	Email    types.String `tfsdk:"email"`
+	Username types.String `tfsdk:"username"`
+	RoleId   types.String `tfsdk:"role_id"`
+	RoleName types.String `tfsdk:"role_name"`
+}
+
+func (d *MembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.name + "_members"
+}
+
+func (d *MembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: fmt.Sprintf("Provides the members of a Snyk %s, with their roles", d.name),
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as " + d.idAttribute,
+			},
+			d.idAttribute: schema.StringAttribute{
+				MarkdownDescription: d.description,
+				Required:            true,
+			},
+			"members": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The members",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Membership ID",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the user",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the user",
+						},
+						"email": schema.StringAttribute{
+							Computed:    true,
+							Description: "Email of the user",
+						},
+						"username": schema.StringAttribute{
+							Computed:    true,
+							Description: "Username of the user",
+						},
+						"role_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the role of the user",
+						},
+						"role_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the role of the user",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *MembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// The model differs with the scope, so the attributes are read one by one.
+	var id types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(d.idAttribute), &id)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.MembershipClient.ListMemberships(ctx, d.scope, id.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Members, got error: %s", err))
+		return
+	}
+
+	members := []MembersDataSourceMemberModel{}
+	for _, m := range res {
+		members = append(members, MembersDataSourceMemberModel{
+			Id:       types.StringValue(m.ID),
+			UserId:   types.StringValue(m.UserID),
+			Name:     types.StringValue(m.Name),
+			Email:    types.StringValue(m.Email),
+			Username: types.StringValue(m.Username),
+			RoleId:   types.StringValue(m.RoleID),
+			RoleName: types.StringValue(m.RoleName),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(d.idAttribute), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("members"), members)...)
+}
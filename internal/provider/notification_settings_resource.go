@@ -0,0 +1,331 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/organization"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+const (
+	notificationScopeOrg  = "org"
+	notificationScopeUser = "user"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &NotificationSettingsResource{}
+var _ resource.ResourceWithImportState = &NotificationSettingsResource{}
+
+func NewNotificationSettingsResource() resource.Resource {
+	return &NotificationSettingsResource{}
+}
+
+// NotificationSettingsResource defines the resource implementation.
+type NotificationSettingsResource struct {
+	client snykclient.Client
+}
+
+// NotificationSettingsResourceModel describes the resource data model.
+type NotificationSettingsResourceModel struct {
+	Id                    types.String                                `tfsdk:"id"`
+	OrganizationId        types.String                                `tfsdk:"organization_id"`
+	Scope                 types.String                                `tfsdk:"scope"`
+	NewIssuesRemediations *OrganizationSettingsIssueNotificationModel `tfsdk:"new_issues_remediations"`
+	ProjectImported       *NotificationSettingModel                   `tfsdk:"project_imported"`
+	TestLimit             *NotificationSettingModel                   `tfsdk:"test_limit"`
+	WeeklyReport          *NotificationSettingModel                   `tfsdk:"weekly_report"`
+}
+
+type NotificationSettingModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+func (r *NotificationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_settings"
+}
+
+func notificationBlock(description string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"enabled": optionalComputedBool("Send the notifications"),
+		},
+	}
+}
+
+func (r *NotificationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the email [notification settings](https://docs.snyk.io/snyk-admin/manage-notifications) of a Snyk organization, or those of the user authenticated by the provider in the organization. " +
+			"There must be at most one such resource per organization and scope, and the new issues notifications of an organization must not also be managed by `snyk_organization_settings`. " +
+			"Settings which are not configured keep their current value. Destroying this resource leaves the settings unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as organization_id for the org scope, organization_id/user for the user scope",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Whose settings are managed, one of [org,user]. The user settings are those of the user the provider authenticates as in the organization, " +
+					"which default to the settings of the organization. Defaults to org.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(notificationScopeOrg),
+				Validators: []validator.String{
+					stringvalidator.OneOf(notificationScopeOrg, notificationScopeUser),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"new_issues_remediations": issueNotificationBlock(),
+			"project_imported":        notificationBlock("Email notifications about imported projects"),
+			"test_limit":              notificationBlock("Email notifications when the organization reaches its test limit"),
+			"weekly_report":           notificationBlock("Weekly email report of the issues of the organization"),
+		},
+	}
+}
+
+func (r *NotificationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// merge returns the setting current, if any, with the configured values of m.
+func (m *NotificationSettingModel) merge(current *organization.NotificationSetting) *organization.NotificationSetting {
+	setting := organization.NotificationSetting{}
+	if current != nil {
+		setting = *current
+	}
+	setting.Inherited = false
+	if enabled := boolPointer(m.Enabled); enabled != nil {
+		setting.Enabled = *enabled
+	}
+	return &setting
+}
+
+// notificationValue returns the model of setting, which may be nil.
+func notificationValue(setting *organization.NotificationSetting) *NotificationSettingModel {
+	return &NotificationSettingModel{
+		Enabled: types.BoolValue(setting != nil && setting.Enabled),
+	}
+}
+
+func (r *NotificationSettingsResource) getSettings(ctx context.Context, data *NotificationSettingsResourceModel) (*organization.NotificationSettings, error) {
+	if data.Scope.ValueString() == notificationScopeUser {
+		return r.client.OrgClient.GetUserNotificationSettings(ctx, data.OrganizationId.ValueString())
+	}
+	return r.client.OrgClient.GetNotificationSettings(ctx, data.OrganizationId.ValueString())
+}
+
+// updateSettings sends the configured settings of plan, and fills plan with
+// the resulting settings.
+func (r *NotificationSettingsResource) updateSettings(ctx context.Context, plan *NotificationSettingsResourceModel) (diags diag.Diagnostics) {
+	// The API requires whole settings, so the remote values complete the
+	// configured ones.
+	current, err := r.getSettings(ctx, plan)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get NotificationSettings, got error: %s", err))
+		return
+	}
+
+	request := &organization.NotificationSettings{}
+	if plan.NewIssuesRemediations != nil {
+		request.NewIssuesRemediations = plan.NewIssuesRemediations.merge(current.NewIssuesRemediations)
+	}
+	if plan.ProjectImported != nil {
+		request.ProjectImported = plan.ProjectImported.merge(current.ProjectImported)
+	}
+	if plan.TestLimit != nil {
+		request.TestLimit = plan.TestLimit.merge(current.TestLimit)
+	}
+	if plan.WeeklyReport != nil {
+		request.WeeklyReport = plan.WeeklyReport.merge(current.WeeklyReport)
+	}
+
+	var settings *organization.NotificationSettings
+	if plan.Scope.ValueString() == notificationScopeUser {
+		settings, err = r.client.OrgClient.UpdateUserNotificationSettings(ctx, plan.OrganizationId.ValueString(), request)
+	} else {
+		settings, err = r.client.OrgClient.UpdateNotificationSettings(ctx, plan.OrganizationId.ValueString(), request)
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update NotificationSettings, got error: %s", err))
+		return
+	}
+
+	setNotificationSettings(plan, settings)
+	return
+}
+
+// setNotificationSettings fills the configured blocks of data with settings.
+func setNotificationSettings(data *NotificationSettingsResourceModel, settings *organization.NotificationSettings) {
+	if data.NewIssuesRemediations != nil {
+		data.NewIssuesRemediations = issueNotificationValue(settings.NewIssuesRemediations)
+	}
+	if data.ProjectImported != nil {
+		data.ProjectImported = notificationValue(settings.ProjectImported)
+	}
+	if data.TestLimit != nil {
+		data.TestLimit = notificationValue(settings.TestLimit)
+	}
+	if data.WeeklyReport != nil {
+		data.WeeklyReport = notificationValue(settings.WeeklyReport)
+	}
+}
+
+func notificationSettingsId(data *NotificationSettingsResourceModel) types.String {
+	if data.Scope.ValueString() == notificationScopeUser {
+		return types.StringValue(data.OrganizationId.ValueString() + "/" + notificationScopeUser)
+	}
+	return data.OrganizationId
+}
+
+func (r *NotificationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *NotificationSettingsResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse NotificationSettings Organization Guid, got error: %s", err))
+		return
+	}
+
+	plan.Id = notificationSettingsId(plan)
+	resp.Diagnostics.Append(r.updateSettings(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *NotificationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *NotificationSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.getSettings(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get NotificationSettings, got error: %s", err))
+		return
+	}
+
+	setNotificationSettings(data, settings)
+	data.Id = notificationSettingsId(data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *NotificationSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = notificationSettingsId(plan)
+	resp.Diagnostics.Append(r.updateSettings(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NotificationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Settings cannot be deleted, they are only removed from the state
+}
+
+func (r *NotificationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, scope, found := strings.Cut(req.ID, "/")
+	if !found {
+		scope = notificationScopeOrg
+	}
+	if organizationId == "" || (scope != notificationScopeOrg && scope != notificationScopeUser) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format organization_id or organization_id/user, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope"), scope)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNotificationSettings(t *testing.T) {
+	snykGroupId := readEnvVarOrSkip(t, "TEST_SNYK_GROUP_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleNotificationSettingsResourceConfig(snykGroupId, "all", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("snyk_notification_settings.org", "id", "snyk_organization.test", "id"),
+					resource.TestCheckResourceAttr("snyk_notification_settings.org", "new_issues_remediations.issue_severity", "all"),
+					resource.TestCheckResourceAttrSet("snyk_notification_settings.org", "new_issues_remediations.enabled"),
+					resource.TestCheckResourceAttr("snyk_notification_settings.org", "weekly_report.enabled", "false"),
+					resource.TestCheckResourceAttr("snyk_notification_settings.user", "scope", "user"),
+					resource.TestCheckResourceAttr("snyk_notification_settings.user", "project_imported.enabled", "false"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleNotificationSettingsResourceConfig(snykGroupId, "high", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_notification_settings.org", "new_issues_remediations.issue_severity", "high"),
+					resource.TestCheckResourceAttr("snyk_notification_settings.org", "weekly_report.enabled", "true"),
+					resource.TestCheckResourceAttr("snyk_notification_settings.user", "project_imported.enabled", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExampleNotificationSettingsResourceConfig(groupId string, severity string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "snyk_organization" "test" {
+  name = "Test snyk notification settings"
+  group_id = %[1]q
+}
+
+resource "snyk_notification_settings" "org" {
+  organization_id = snyk_organization.test.id
+  new_issues_remediations {
+    issue_severity = %[2]q
+  }
+  weekly_report {
+    enabled = %[3]t
+  }
+}
+
+resource "snyk_notification_settings" "user" {
+  organization_id = snyk_organization.test.id
+  scope = "user"
+  project_imported {
+    enabled = %[3]t
+  }
+}`, groupId, severity, enabled)
+}
@@ -0,0 +1,74 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOrganizationInvite(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	inviteEmail := readEnvVarOrSkip(t, "TEST_SNYK_INVITE_EMAIL")
+	collaboratorRoleId := readEnvVarOrSkip(t, "TEST_SNYK_ORG_COLLABORATOR_ROLE_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleOrganizationInviteResourceConfig(snykOrgId, inviteEmail, collaboratorRoleId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snyk_organization_invite.test", "id"),
+					resource.TestCheckResourceAttr("snyk_organization_invite.test", "email", inviteEmail),
+					resource.TestCheckResourceAttr("snyk_organization_invite.test", "role_id", collaboratorRoleId),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snyk_organization_invites.test", "invites.*", map[string]string{
+						"email":   inviteEmail,
+						"role_id": collaboratorRoleId,
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_organization_invite.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["snyk_organization_invite.test"]
+					return rs.Primary.Attributes["organization_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExampleOrganizationInviteResourceConfig(orgId string, email string, roleId string) string {
+	return fmt.Sprintf(`
+resource "snyk_organization_invite" "test" {
+  organization_id = %[1]q
+  email = %[2]q
+  role_id = %[3]q
+}
+
+data "snyk_organization_invites" "test" {
+  organization_id = snyk_organization_invite.test.organization_id
+  depends_on = [snyk_organization_invite.test]
+}`, orgId, email, roleId)
+}
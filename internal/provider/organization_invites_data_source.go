@@ -0,0 +1,142 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &OrganizationInvitesDataSource{}
+
+func NewOrganizationInvitesDataSource() datasource.DataSource {
+	return &OrganizationInvitesDataSource{}
+}
+
+// OrganizationInvitesDataSource defines the data source implementation.
+type OrganizationInvitesDataSource struct {
+	client snykclient.Client
+}
+
+// OrganizationInvitesDataSourceModel describes the data source data model.
+type OrganizationInvitesDataSourceModel struct {
+	Id             types.String                               `tfsdk:"id"`
+	OrganizationId types.String                               `tfsdk:"organization_id"`
+	Invites        []OrganizationInvitesDataSourceInviteModel `tfsdk:"invites"`
+}
+
+type OrganizationInvitesDataSourceInviteModel struct {
+	Id     types.String `tfsdk:"id"`
+	Email  types.String `tfsdk:"email"`
+	RoleId types.String `tfsdk:"role_id"`
+}
+
+func (d *OrganizationInvitesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invites"
+}
+
+func (d *OrganizationInvitesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Provides the pending invites of a Snyk organization",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as organization_id",
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+			},
+			"invites": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The pending invites",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Invite ID",
+						},
+						"email": schema.StringAttribute{
+							Computed:    true,
+							Description: "Invited email",
+						},
+						"role_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the organization role given once the invite is accepted",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationInvitesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *OrganizationInvitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationInvitesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.MembershipClient.ListInvites(ctx, data.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list OrganizationInvites, got error: %s", err))
+		return
+	}
+
+	data.Id = data.OrganizationId
+	data.Invites = []OrganizationInvitesDataSourceInviteModel{}
+	for _, invite := range res {
+		data.Invites = append(data.Invites, OrganizationInvitesDataSourceInviteModel{
+			Id:     types.StringValue(invite.ID),
+			Email:  types.StringValue(invite.Email),
+			RoleId: types.StringValue(invite.RoleID),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
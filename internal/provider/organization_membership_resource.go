@@ -0,0 +1,418 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/membership"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &OrganizationMembershipResource{}
+var _ resource.ResourceWithImportState = &OrganizationMembershipResource{}
+
+func NewOrganizationMembershipResource() resource.Resource {
+	return &OrganizationMembershipResource{}
+}
+
+// OrganizationMembershipResource defines the resource implementation.
+type OrganizationMembershipResource struct {
+	client snykclient.Client
+}
+
+// OrganizationMembershipResourceModel describes the resource data model.
+type OrganizationMembershipResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	OrganizationId  types.String `tfsdk:"organization_id"`
+	UserId          types.String `tfsdk:"user_id"`
+	Email           types.String `tfsdk:"email"`
+	RoleId          types.String `tfsdk:"role_id"`
+	InviteIfMissing types.Bool   `tfsdk:"invite_if_missing"`
+	InviteId        types.String `tfsdk:"invite_id"`
+}
+
+// pending reports whether the membership is an invite which has not been
+// accepted yet.
+func (m *OrganizationMembershipResourceModel) pending() bool {
+	return !m.InviteId.IsNull() && m.Id.Equal(m.InviteId)
+}
+
+func (r *OrganizationMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_membership"
+}
+
+func (r *OrganizationMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Gives a role in a Snyk organization to a user, identified by its id or email. " +
+			"With invite_if_missing, an email which is not a user of the group of the organization yet is invited to join it; " +
+			"the resource then tracks the invite until it is accepted.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Membership ID, or the invite ID while the invite is pending",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the user. Conflicts with email.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("email")),
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Email of the user",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization role of the user",
+				Required:            true,
+			},
+			"invite_if_missing": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Invite the email when it is not a user of the group of the organization. Defaults to false.",
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("email")),
+				},
+			},
+			"invite_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the invite sent to the email, if any",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+func (r *OrganizationMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *OrganizationMembershipResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId := plan.OrganizationId.ValueString()
+	_, err := uuid.Parse(orgId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse OrganizationMembership Organization Guid, got error: %s", err))
+		return
+	}
+
+	plan.InviteId = types.StringNull()
+	if plan.UserId.IsUnknown() {
+		found, diags := r.findUser(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !found {
+			inviteId, err := r.client.MembershipClient.CreateInvite(ctx, orgId, plan.Email.ValueString(), plan.RoleId.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite OrganizationMembership email, got error: %s", err))
+				return
+			}
+			plan.Id = types.StringValue(inviteId)
+			plan.InviteId = types.StringValue(inviteId)
+			plan.UserId = types.StringNull()
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			return
+		}
+	}
+
+	membershipId, err := r.client.MembershipClient.CreateMembership(ctx, membership.SCOPE_ORG, orgId, plan.UserId.ValueString(), plan.RoleId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OrganizationMembership, got error: %s", err))
+		return
+	}
+	plan.Id = types.StringValue(membershipId)
+
+	if _, diags := r.readMembership(ctx, plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		// Save the membership so that it is tainted rather than orphaned
+		if plan.Email.IsUnknown() {
+			plan.Email = types.StringNull()
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findUser sets the user id of plan from its email. It returns false if the
+// email is not a user of the group of the organization and should be
+// invited.
+func (r *OrganizationMembershipResource) findUser(ctx context.Context, plan *OrganizationMembershipResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	email := plan.Email.ValueString()
+
+	org, err := r.client.OrgClient.GetOrganization(ctx, plan.OrganizationId.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get OrganizationMembership Organization, got error: %s", err))
+		return false, diags
+	}
+
+	var userId string
+	if org.GroupId != "" {
+		user, err := r.client.MembershipClient.FindGroupUser(ctx, org.GroupId, email)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to find OrganizationMembership user, got error: %s", err))
+			return false, diags
+		}
+		if user != nil {
+			userId = user.ID
+		}
+	}
+
+	if userId == "" {
+		if !plan.InviteIfMissing.ValueBool() {
+			diags.AddError("Unknown User", fmt.Sprintf("No user of the group of organization %s has the email %s, set invite_if_missing to invite it", plan.OrganizationId.ValueString(), email))
+		}
+		return false, diags
+	}
+
+	plan.UserId = types.StringValue(userId)
+	return true, diags
+}
+
+// readMembership fills data with the remote membership. It returns false if
+// the membership does not exist anymore.
+func (r *OrganizationMembershipResource) readMembership(ctx context.Context, data *OrganizationMembershipResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	orgId := data.OrganizationId.ValueString()
+
+	var res *membership.Membership
+	if data.pending() {
+		// The membership is adopted once the invite is accepted.
+		memberships, err := r.client.MembershipClient.ListMemberships(ctx, membership.SCOPE_ORG, orgId, data.Email.ValueString())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to get OrganizationMembership, got error: %s", err))
+			return false, diags
+		}
+		if len(memberships) == 0 {
+			return true, diags
+		}
+		res = memberships[0]
+	} else {
+		var err error
+		res, err = r.client.MembershipClient.GetMembership(ctx, membership.SCOPE_ORG, orgId, data.Id.ValueString())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to get OrganizationMembership, got error: %s", err))
+			return false, diags
+		}
+		if res == nil {
+			return false, diags
+		}
+	}
+
+	data.Id = types.StringValue(res.ID)
+	data.UserId = types.StringValue(res.UserID)
+	data.Email = emailValue(data.Email, res.Email)
+	data.RoleId = types.StringValue(res.RoleID)
+	return true, diags
+}
+
+// emailValue returns the email reported by the API, keeping the current value
+// when it only differs by case so that the configured address does not drift.
+func emailValue(current types.String, email string) types.String {
+	if !current.IsNull() && !current.IsUnknown() && strings.EqualFold(current.ValueString(), email) {
+		return current
+	}
+	return types.StringValue(email)
+}
+
+func (r *OrganizationMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OrganizationMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.readMembership(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if data.InviteIfMissing.IsNull() {
+		data.InviteIfMissing = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *OrganizationMembershipResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId := plan.OrganizationId.ValueString()
+	// A pending invite has no user id, which the plan leaves unknown.
+	plan.Id = state.Id
+	plan.InviteId = state.InviteId
+	plan.UserId = state.UserId
+	plan.Email = state.Email
+
+	if !plan.RoleId.Equal(state.RoleId) {
+		if state.pending() {
+			// The role of an invite cannot change, so it is sent again.
+			err := r.client.MembershipClient.DeleteInvite(ctx, orgId, state.InviteId.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OrganizationMembership invite, got error: %s", err))
+				return
+			}
+			inviteId, err := r.client.MembershipClient.CreateInvite(ctx, orgId, plan.Email.ValueString(), plan.RoleId.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to invite OrganizationMembership email, got error: %s", err))
+				return
+			}
+			plan.Id = types.StringValue(inviteId)
+			plan.InviteId = types.StringValue(inviteId)
+		} else {
+			err := r.client.MembershipClient.UpdateMembership(ctx, membership.SCOPE_ORG, orgId, plan.Id.ValueString(), plan.RoleId.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update OrganizationMembership, got error: %s", err))
+				return
+			}
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId := data.OrganizationId.ValueString()
+	if data.pending() {
+		err := r.client.MembershipClient.DeleteInvite(ctx, orgId, data.InviteId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OrganizationMembership invite, got error: %s", err))
+		}
+		return
+	}
+
+	err := r.client.MembershipClient.DeleteMembership(ctx, membership.SCOPE_ORG, orgId, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OrganizationMembership, got error: %s", err))
+		return
+	}
+}
+
+func (r *OrganizationMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, membershipId, found := strings.Cut(req.ID, "/")
+	if !found || organizationId == "" || membershipId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format organization_id/membership_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), membershipId)...)
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOrganizationMembership(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	userEmail := readEnvVarOrSkip(t, "TEST_SNYK_USER_EMAIL")
+	collaboratorRoleId := readEnvVarOrSkip(t, "TEST_SNYK_ORG_COLLABORATOR_ROLE_ID")
+	adminRoleId := readEnvVarOrSkip(t, "TEST_SNYK_ORG_ADMIN_ROLE_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleOrganizationMembershipResourceConfig(snykOrgId, userEmail, collaboratorRoleId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snyk_organization_membership.test", "id"),
+					resource.TestCheckResourceAttrSet("snyk_organization_membership.test", "user_id"),
+					resource.TestCheckResourceAttr("snyk_organization_membership.test", "role_id", collaboratorRoleId),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snyk_organization_members.test", "members.*", map[string]string{
+						"email":   userEmail,
+						"role_id": collaboratorRoleId,
+					}),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleOrganizationMembershipResourceConfig(snykOrgId, userEmail, adminRoleId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_organization_membership.test", "role_id", adminRoleId),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExampleOrganizationMembershipResourceConfig(orgId string, email string, roleId string) string {
+	return fmt.Sprintf(`
+resource "snyk_organization_membership" "test" {
+  organization_id = %[1]q
+  email = %[2]q
+  role_id = %[3]q
+}
+
+data "snyk_organization_members" "test" {
+  organization_id = snyk_organization_membership.test.organization_id
+  depends_on = [snyk_organization_membership.test]
+}`, orgId, email, roleId)
+}
@@ -106,7 +106,7 @@ func (r *OrganizationServiceAccountResource) Schema(ctx context.Context, req res
 				Required:            true,
 			},
 			"role_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the role which the created service account should use. Obtained in the Snyk UI, via \"Group Page\" -> \"Settings\" -> \"Member Roles\" -> \"Create new Role\". Can be shared among multiple accounts.",
+				MarkdownDescription: "The ID of the role which the created service account should use. Obtained in the Snyk UI, via \"Group Page\" -> \"Settings\" -> \"Member Roles\" -> \"Create new Role\", or from a snyk_custom_role resource. Can be shared among multiple accounts.",
 				Required:            true,
 			},
 			"organization_id": schema.StringAttribute{
@@ -0,0 +1,381 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/organization"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// defaultOrganizationSettings are the settings of a new organization, which
+// are restored on destroy when reset_on_destroy is set.
+var defaultOrganizationSettings = OrganizationSettingsResourceModel{
+	RequestAccessEnabled: types.BoolValue(false),
+	SnykCodeEnabled:      types.BoolValue(false),
+	NewIssuesNotification: &OrganizationSettingsIssueNotificationModel{
+		Enabled:       types.BoolValue(true),
+		IssueSeverity: types.StringValue("high"),
+		IssueType:     types.StringValue("vuln"),
+	},
+}
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &OrganizationSettingsResource{}
+var _ resource.ResourceWithImportState = &OrganizationSettingsResource{}
+
+func NewOrganizationSettingsResource() resource.Resource {
+	return &OrganizationSettingsResource{}
+}
+
+// OrganizationSettingsResource defines the resource implementation.
+type OrganizationSettingsResource struct {
+	client snykclient.Client
+}
+
+// OrganizationSettingsResourceModel describes the resource data model.
+type OrganizationSettingsResourceModel struct {
+	Id                    types.String                                `tfsdk:"id"`
+	OrganizationId        types.String                                `tfsdk:"organization_id"`
+	RequestAccessEnabled  types.Bool                                  `tfsdk:"request_access_enabled"`
+	SnykCodeEnabled       types.Bool                                  `tfsdk:"snyk_code_enabled"`
+	NewIssuesNotification *OrganizationSettingsIssueNotificationModel `tfsdk:"new_issues_notification"`
+	ResetOnDestroy        types.Bool                                  `tfsdk:"reset_on_destroy"`
+}
+
+type OrganizationSettingsIssueNotificationModel struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	IssueSeverity types.String `tfsdk:"issue_severity"`
+	IssueType     types.String `tfsdk:"issue_type"`
+}
+
+// merge returns the setting current, if any, with the configured values of m.
+func (m *OrganizationSettingsIssueNotificationModel) merge(current *organization.IssueNotificationSetting) *organization.IssueNotificationSetting {
+	setting := organization.IssueNotificationSetting{}
+	if current != nil {
+		setting = *current
+	}
+	setting.Inherited = false
+	if enabled := boolPointer(m.Enabled); enabled != nil {
+		setting.Enabled = *enabled
+	}
+	if severity := stringPointer(m.IssueSeverity); severity != nil {
+		setting.IssueSeverity = *severity
+	}
+	if issueType := stringPointer(m.IssueType); issueType != nil {
+		setting.IssueType = *issueType
+	}
+	return &setting
+}
+
+// issueNotificationValue returns the model of setting, which may be nil.
+func issueNotificationValue(setting *organization.IssueNotificationSetting) *OrganizationSettingsIssueNotificationModel {
+	if setting == nil {
+		setting = &organization.IssueNotificationSetting{}
+	}
+	return &OrganizationSettingsIssueNotificationModel{
+		Enabled:       types.BoolValue(setting.Enabled),
+		IssueSeverity: types.StringValue(setting.IssueSeverity),
+		IssueType:     types.StringValue(setting.IssueType),
+	}
+}
+
+func (r *OrganizationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_settings"
+}
+
+// issueNotificationBlock returns the schema of an
+// OrganizationSettingsIssueNotificationModel.
+func issueNotificationBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Email notifications about new issues and remediations",
+		Attributes: map[string]schema.Attribute{
+			"enabled": optionalComputedBool("Send the notifications"),
+			"issue_severity": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Severity of the issues to notify about, one of [all,high]",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "high"),
+				},
+			},
+			"issue_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Type of the issues to notify about, one of [all,vuln,license,none]",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "vuln", "license", "none"),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the [settings](https://docs.snyk.io/snyk-admin/manage-groups-and-organizations/organizations/organization-general-settings) of a Snyk organization. " +
+			"There must be at most one such resource per organization. Settings which are not configured keep their current value. " +
+			"Destroying this resource leaves the settings unchanged, unless reset_on_destroy is set, in which case access requests and Snyk Code are disabled " +
+			"and new issues notifications are sent for high severity vulnerabilities. " +
+			"Infrastructure as Code settings are managed by the snyk_iac_settings resource. " +
+			"The default test frequency and the container settings cannot be managed, as the Snyk API does not expose them.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as organization_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"request_access_enabled": optionalComputedBool("Allow users outside the organization to request access to it"),
+			"snyk_code_enabled":      optionalComputedBool("Enable Snyk Code, i.e. static application security testing"),
+			"reset_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Reset the settings to their defaults when the resource is destroyed. Defaults to false.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"new_issues_notification": issueNotificationBlock(),
+		},
+	}
+}
+
+func (r *OrganizationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+func (r *OrganizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *OrganizationSettingsResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse OrganizationSettings Organization Guid, got error: %s", err))
+		return
+	}
+
+	plan.Id = plan.OrganizationId
+	resp.Diagnostics.Append(r.updateSettings(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// updateSettings sends the configured settings of plan, and fills plan with
+// the resulting settings.
+func (r *OrganizationSettingsResource) updateSettings(ctx context.Context, plan *OrganizationSettingsResourceModel) (diags diag.Diagnostics) {
+	orgId := plan.OrganizationId.ValueString()
+
+	if enabled := boolPointer(plan.RequestAccessEnabled); enabled != nil {
+		_, err := r.client.OrgClient.UpdateOrganizationSettings(ctx, orgId, &organization.Settings{
+			RequestAccess: &organization.RequestAccessSettings{Enabled: *enabled},
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update OrganizationSettings, got error: %s", err))
+			return
+		}
+	}
+
+	if enabled := boolPointer(plan.SnykCodeEnabled); enabled != nil {
+		_, err := r.client.OrgClient.UpdateSastSettings(ctx, orgId, *enabled)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update OrganizationSettings Snyk Code settings, got error: %s", err))
+			return
+		}
+	}
+
+	if plan.NewIssuesNotification != nil {
+		// The API requires the whole setting, so the remote values complete
+		// the configured ones.
+		current, err := r.client.OrgClient.GetNotificationSettings(ctx, orgId)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to get OrganizationSettings notification settings, got error: %s", err))
+			return
+		}
+		setting := plan.NewIssuesNotification.merge(current.NewIssuesRemediations)
+		_, err = r.client.OrgClient.UpdateNotificationSettings(ctx, orgId, &organization.NotificationSettings{NewIssuesRemediations: setting})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update OrganizationSettings notification settings, got error: %s", err))
+			return
+		}
+	}
+
+	_, readDiags := r.readSettings(ctx, plan)
+	diags.Append(readDiags...)
+	return
+}
+
+// readSettings fills data with the remote settings. It returns false if the
+// organization does not exist.
+func (r *OrganizationSettingsResource) readSettings(ctx context.Context, data *OrganizationSettingsResourceModel) (found bool, diags diag.Diagnostics) {
+	orgId := data.OrganizationId.ValueString()
+
+	settings, err := r.client.OrgClient.GetOrganizationSettings(ctx, orgId)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		return false, diags
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get OrganizationSettings, got error: %s", err))
+		return
+	}
+	data.RequestAccessEnabled = types.BoolValue(settings.RequestAccess != nil && settings.RequestAccess.Enabled)
+
+	sastSettings, err := r.client.OrgClient.GetSastSettings(ctx, orgId)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get OrganizationSettings Snyk Code settings, got error: %s", err))
+		return
+	}
+	data.SnykCodeEnabled = types.BoolValue(sastSettings.SastEnabled)
+
+	if data.NewIssuesNotification != nil {
+		notificationSettings, err := r.client.OrgClient.GetNotificationSettings(ctx, orgId)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to get OrganizationSettings notification settings, got error: %s", err))
+			return
+		}
+		data.NewIssuesNotification = issueNotificationValue(notificationSettings.NewIssuesRemediations)
+	}
+
+	return true, diags
+}
+
+func (r *OrganizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OrganizationSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.readSettings(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = data.OrganizationId
+	if data.ResetOnDestroy.IsNull() {
+		data.ResetOnDestroy = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *OrganizationSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.OrganizationId
+	resp.Diagnostics.Append(r.updateSettings(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !data.ResetOnDestroy.ValueBool() {
+		return
+	}
+
+	defaults := defaultOrganizationSettings
+	defaults.OrganizationId = data.OrganizationId
+	resp.Diagnostics.Append(r.updateSettings(ctx, &defaults)...)
+}
+
+func (r *OrganizationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("organization_id"), req, resp)
+}
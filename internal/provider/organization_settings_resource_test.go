@@ -0,0 +1,69 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOrganizationSettings(t *testing.T) {
+	snykGroupId := readEnvVarOrSkip(t, "TEST_SNYK_GROUP_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleOrganizationSettingsResourceConfig(snykGroupId, true, "all"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("snyk_organization_settings.test", "id", "snyk_organization.test", "id"),
+					resource.TestCheckResourceAttr("snyk_organization_settings.test", "request_access_enabled", "true"),
+					resource.TestCheckResourceAttrSet("snyk_organization_settings.test", "snyk_code_enabled"),
+					resource.TestCheckResourceAttr("snyk_organization_settings.test", "new_issues_notification.issue_severity", "all"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleOrganizationSettingsResourceConfig(snykGroupId, false, "high"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_organization_settings.test", "request_access_enabled", "false"),
+					resource.TestCheckResourceAttr("snyk_organization_settings.test", "new_issues_notification.issue_severity", "high"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExampleOrganizationSettingsResourceConfig(groupId string, requestAccess bool, severity string) string {
+	return fmt.Sprintf(`
+resource "snyk_organization" "test" {
+  name = "Test snyk org settings"
+  group_id = %[1]q
+}
+
+resource "snyk_organization_settings" "test" {
+  organization_id = snyk_organization.test.id
+  request_access_enabled = %[2]t
+  new_issues_notification {
+    issue_severity = %[3]q
+  }
+}`, groupId, requestAccess, severity)
+}
@@ -0,0 +1,564 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/policy"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/project"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &PolicyResource{}
+var _ resource.ResourceWithImportState = &PolicyResource{}
+var _ resource.ResourceWithValidateConfig = &PolicyResource{}
+
+func NewPolicyResource() resource.Resource {
+	return &PolicyResource{}
+}
+
+// PolicyResource defines the resource implementation.
+type PolicyResource struct {
+	client snykclient.Client
+}
+
+// PolicyResourceModel describes the resource data model.
+type PolicyResourceModel struct {
+	Id                types.String                          `tfsdk:"id"`
+	GroupId           types.String                          `tfsdk:"group_id"`
+	Name              types.String                          `tfsdk:"name"`
+	Description       types.String                          `tfsdk:"description"`
+	Type              types.String                          `tfsdk:"type"`
+	OrganizationIds   types.Set                             `tfsdk:"organization_ids"`
+	ProjectAttributes *PolicyResourceProjectAttributesModel `tfsdk:"project_attributes"`
+	Rules             []PolicyResourceRuleModel             `tfsdk:"rules"`
+}
+
+type PolicyResourceProjectAttributesModel struct {
+	Criticality types.Set `tfsdk:"criticality"`
+	Environment types.Set `tfsdk:"environment"`
+	Lifecycle   types.Set `tfsdk:"lifecycle"`
+}
+
+type PolicyResourceRuleModel struct {
+	Name       types.String                  `tfsdk:"name"`
+	Conditions PolicyResourceConditionsModel `tfsdk:"conditions"`
+	Action     PolicyResourceActionModel     `tfsdk:"action"`
+}
+
+type PolicyResourceConditionsModel struct {
+	Severities        types.Set     `tfsdk:"severities"`
+	ExploitMaturities types.Set     `tfsdk:"exploit_maturities"`
+	CvssScoreMin      types.Float64 `tfsdk:"cvss_score_min"`
+	CvssScoreMax      types.Float64 `tfsdk:"cvss_score_max"`
+	LicenseTypes      types.Set     `tfsdk:"license_types"`
+}
+
+type PolicyResourceActionModel struct {
+	Type         types.String `tfsdk:"type"`
+	Severity     types.String `tfsdk:"severity"`
+	IgnoreType   types.String `tfsdk:"ignore_type"`
+	Reason       types.String `tfsdk:"reason"`
+	Instructions types.String `tfsdk:"instructions"`
+}
+
+func (r *PolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+// stringSetAttribute returns an optional set of strings, restricted to values.
+func stringSetAttribute(description string, values ...string) schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		ElementType:         types.StringType,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.OneOf(values...)),
+		},
+	}
+}
+
+func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages a [security or license policy](https://docs.snyk.io/manage-risk/policies) of a Snyk group. " +
+			"A policy applies to the projects of the organizations in organization_ids, or to the projects matching project_attributes, and its rules are applied in order.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Policy ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Group GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the policy",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the policy",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the policy: " + strings.Join(policy.Types, ", "),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(policy.Types...),
+				},
+			},
+			"organization_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the organizations the policy is attached to",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ConflictsWith(path.MatchRoot("project_attributes")),
+				},
+			},
+			"project_attributes": schema.SingleNestedAttribute{
+				MarkdownDescription: "Attributes of the projects the policy applies to, in all the organizations of the group",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"criticality": stringSetAttribute("Business criticalities of the projects: "+strings.Join(project.BusinessCriticalities, ", "), project.BusinessCriticalities...),
+					"environment": stringSetAttribute("Environments of the projects: "+strings.Join(project.Environments, ", "), project.Environments...),
+					"lifecycle":   stringSetAttribute("Lifecycle stages of the projects: "+strings.Join(project.Lifecycles, ", "), project.Lifecycles...),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Rules of the policy, applied in order",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the rule",
+							Required:            true,
+						},
+						"conditions": schema.SingleNestedAttribute{
+							MarkdownDescription: "Conditions an issue must all match for the action to apply",
+							Required:            true,
+							Attributes: map[string]schema.Attribute{
+								"severities":         stringSetAttribute("Severities of the issues, for security policies: "+strings.Join(policy.Severities, ", "), policy.Severities...),
+								"exploit_maturities": stringSetAttribute("Exploit maturities of the issues, for security policies: "+strings.Join(policy.ExploitMaturities, ", "), policy.ExploitMaturities...),
+								"cvss_score_min": schema.Float64Attribute{
+									MarkdownDescription: "Minimum CVSS score of the issues, for security policies",
+									Optional:            true,
+									Validators: []validator.Float64{
+										float64validator.Between(0, 10),
+									},
+								},
+								"cvss_score_max": schema.Float64Attribute{
+									MarkdownDescription: "Maximum CVSS score of the issues, for security policies",
+									Optional:            true,
+									Validators: []validator.Float64{
+										float64validator.Between(0, 10),
+									},
+								},
+								"license_types": schema.SetAttribute{
+									MarkdownDescription: "SPDX identifiers of the licenses, such as `GPL-3.0`, for license policies",
+									Optional:            true,
+									ElementType:         types.StringType,
+									Validators: []validator.Set{
+										setvalidator.SizeAtLeast(1),
+									},
+								},
+							},
+						},
+						"action": schema.SingleNestedAttribute{
+							MarkdownDescription: "Action applied to the matching issues",
+							Required:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "Type of the action: `change_severity` or `ignore` for security policies, `set_license_severity` for license policies",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(policy.Actions...),
+									},
+								},
+								"severity": schema.StringAttribute{
+									MarkdownDescription: "Severity given to the issues, by change_severity and set_license_severity actions: " + strings.Join(policy.LicenseSeverities, ", ") + ", or critical for change_severity",
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(append([]string{"critical"}, policy.LicenseSeverities...)...),
+									},
+								},
+								"ignore_type": schema.StringAttribute{
+									MarkdownDescription: "Type of the ignores, for ignore actions: " + strings.Join(policy.IgnoreTypes, ", "),
+									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(policy.IgnoreTypes...),
+									},
+								},
+								"reason": schema.StringAttribute{
+									MarkdownDescription: "Reason of the ignores, for ignore actions",
+									Optional:            true,
+								},
+								"instructions": schema.StringAttribute{
+									MarkdownDescription: "Instructions shown for the licenses, for set_license_severity actions",
+									Optional:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() {
+		return
+	}
+
+	for i, rule := range data.Rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+		conditions := rule.Conditions
+		action := rule.Action
+
+		if data.Type.ValueString() == policy.TYPE_LICENSE {
+			if !conditions.Severities.IsNull() || !conditions.ExploitMaturities.IsNull() || !conditions.CvssScoreMin.IsNull() || !conditions.CvssScoreMax.IsNull() {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("conditions"), "Invalid Attribute Combination", "license policies only take license_types conditions")
+			}
+			if conditions.LicenseTypes.IsNull() {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("conditions").AtName("license_types"), "Missing Attribute", "license_types must be set in the rules of license policies")
+			}
+			if !action.Type.IsUnknown() && action.Type.ValueString() != policy.ACTION_SET_LICENSE_SEVERITY {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("action").AtName("type"), "Invalid Attribute Value", "license policies only take set_license_severity actions")
+			}
+		} else {
+			if !conditions.LicenseTypes.IsNull() {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("conditions").AtName("license_types"), "Invalid Attribute Combination", "license_types only applies to license policies")
+			}
+			if action.Type.ValueString() == policy.ACTION_SET_LICENSE_SEVERITY {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("action").AtName("type"), "Invalid Attribute Value", "set_license_severity only applies to license policies")
+			}
+		}
+
+		switch action.Type.ValueString() {
+		case policy.ACTION_CHANGE_SEVERITY, policy.ACTION_SET_LICENSE_SEVERITY:
+			if action.Severity.IsNull() {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("action").AtName("severity"), "Missing Attribute", fmt.Sprintf("severity must be set for %s actions", action.Type.ValueString()))
+			}
+			if !action.IgnoreType.IsNull() || !action.Reason.IsNull() {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("action"), "Invalid Attribute Combination", "ignore_type and reason only apply to ignore actions")
+			}
+		case policy.ACTION_IGNORE:
+			if action.IgnoreType.IsNull() {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("action").AtName("ignore_type"), "Missing Attribute", "ignore_type must be set for ignore actions")
+			}
+			if !action.Severity.IsNull() {
+				resp.Diagnostics.AddAttributeError(rulePath.AtName("action").AtName("severity"), "Invalid Attribute Combination", "severity does not apply to ignore actions")
+			}
+		}
+	}
+}
+
+func (r *PolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// policy returns the policy to send to the API.
+func (m *PolicyResourceModel) policy(ctx context.Context) (policy.Policy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	p := policy.Policy{
+		ID:          m.Id.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Type:        m.Type.ValueString(),
+		Rules:       []policy.Rule{},
+	}
+
+	p.Attachments.OrganizationIDs, d = stringSetPointer(ctx, m.OrganizationIds)
+	diags.Append(d...)
+
+	if m.ProjectAttributes != nil {
+		p.Attachments.ProjectAttributes = &policy.ProjectAttributes{}
+		p.Attachments.ProjectAttributes.Criticality, d = stringSetPointer(ctx, m.ProjectAttributes.Criticality)
+		diags.Append(d...)
+		p.Attachments.ProjectAttributes.Environment, d = stringSetPointer(ctx, m.ProjectAttributes.Environment)
+		diags.Append(d...)
+		p.Attachments.ProjectAttributes.Lifecycle, d = stringSetPointer(ctx, m.ProjectAttributes.Lifecycle)
+		diags.Append(d...)
+	}
+
+	for _, rule := range m.Rules {
+		r := policy.Rule{
+			Name: rule.Name.ValueString(),
+			Action: policy.Action{
+				Type:         rule.Action.Type.ValueString(),
+				Severity:     stringPointer(rule.Action.Severity),
+				IgnoreType:   stringPointer(rule.Action.IgnoreType),
+				Reason:       stringPointer(rule.Action.Reason),
+				Instructions: stringPointer(rule.Action.Instructions),
+			},
+		}
+
+		r.Conditions.Severities, d = stringSetPointer(ctx, rule.Conditions.Severities)
+		diags.Append(d...)
+		r.Conditions.ExploitMaturities, d = stringSetPointer(ctx, rule.Conditions.ExploitMaturities)
+		diags.Append(d...)
+		r.Conditions.CvssScoreMin = float64Pointer(rule.Conditions.CvssScoreMin)
+		r.Conditions.CvssScoreMax = float64Pointer(rule.Conditions.CvssScoreMax)
+		r.Conditions.LicenseTypes, d = stringSetPointer(ctx, rule.Conditions.LicenseTypes)
+		diags.Append(d...)
+
+		p.Rules = append(p.Rules, r)
+	}
+
+	return p, diags
+}
+
+// setPolicy copies the policy returned by the API to the model, so that
+// changes made outside of Terraform are detected.
+func (m *PolicyResourceModel) setPolicy(ctx context.Context, p *policy.Policy) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	m.Id = types.StringValue(p.ID)
+	m.Name = types.StringValue(p.Name)
+	m.Description = types.StringValue(p.Description)
+	m.Type = types.StringValue(p.Type)
+
+	m.OrganizationIds, d = stringSetPointerValue(ctx, p.Attachments.OrganizationIDs)
+	diags.Append(d...)
+
+	m.ProjectAttributes = nil
+	if attributes := p.Attachments.ProjectAttributes; attributes != nil {
+		m.ProjectAttributes = &PolicyResourceProjectAttributesModel{}
+		m.ProjectAttributes.Criticality, d = stringSetPointerValue(ctx, attributes.Criticality)
+		diags.Append(d...)
+		m.ProjectAttributes.Environment, d = stringSetPointerValue(ctx, attributes.Environment)
+		diags.Append(d...)
+		m.ProjectAttributes.Lifecycle, d = stringSetPointerValue(ctx, attributes.Lifecycle)
+		diags.Append(d...)
+	}
+
+	m.Rules = []PolicyResourceRuleModel{}
+	for _, rule := range p.Rules {
+		r := PolicyResourceRuleModel{
+			Name: types.StringValue(rule.Name),
+			Action: PolicyResourceActionModel{
+				Type:         types.StringValue(rule.Action.Type),
+				Severity:     types.StringPointerValue(rule.Action.Severity),
+				IgnoreType:   types.StringPointerValue(rule.Action.IgnoreType),
+				Reason:       types.StringPointerValue(rule.Action.Reason),
+				Instructions: types.StringPointerValue(rule.Action.Instructions),
+			},
+		}
+
+		r.Conditions.Severities, d = stringSetPointerValue(ctx, rule.Conditions.Severities)
+		diags.Append(d...)
+		r.Conditions.ExploitMaturities, d = stringSetPointerValue(ctx, rule.Conditions.ExploitMaturities)
+		diags.Append(d...)
+		r.Conditions.CvssScoreMin = types.Float64PointerValue(rule.Conditions.CvssScoreMin)
+		r.Conditions.CvssScoreMax = types.Float64PointerValue(rule.Conditions.CvssScoreMax)
+		r.Conditions.LicenseTypes, d = stringSetPointerValue(ctx, rule.Conditions.LicenseTypes)
+		diags.Append(d...)
+
+		m.Rules = append(m.Rules, r)
+	}
+
+	return diags
+}
+
+func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *PolicyResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.GroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse Policy Group Guid, got error: %s", err))
+		return
+	}
+
+	request, diags := plan.policy(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PolicyClient.CreatePolicy(ctx, plan.GroupId.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Policy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setPolicy(ctx, res)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PolicyClient.GetPolicy(ctx, data.GroupId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Policy, got error: %s", err))
+		return
+	}
+
+	if res == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setPolicy(ctx, res)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *PolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := plan.policy(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.PolicyClient.UpdatePolicy(ctx, plan.GroupId.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Policy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setPolicy(ctx, res)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *PolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.PolicyClient.DeletePolicy(ctx, data.GroupId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Policy, got error: %s", err))
+		return
+	}
+}
+
+func (r *PolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupId, policyId, found := strings.Cut(req.ID, "/")
+	if !found || groupId == "" || policyId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format group_id/policy_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), policyId)...)
+}
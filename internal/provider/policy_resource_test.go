@@ -0,0 +1,94 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPolicy(t *testing.T) {
+	snykGroupId := readEnvVarOrSkip(t, "TEST_SNYK_GROUP_ID")
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExamplePolicyResourceConfig(snykGroupId, snykOrgId, "security", "high"),
+				ExpectError: regexp.MustCompile("license_types only applies to license policies"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExamplePolicyResourceConfig(snykGroupId, snykOrgId, "license", "high"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snyk_policy.test", "id"),
+					resource.TestCheckResourceAttr("snyk_policy.test", "rules.0.action.severity", "high"),
+					resource.TestCheckResourceAttr("snyk_policy.test", "organization_ids.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_policy.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccPolicyImportStateId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExamplePolicyResourceConfig(snykGroupId, snykOrgId, "license", "medium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_policy.test", "rules.0.action.severity", "medium"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPolicyImportStateId(s *terraform.State) (string, error) {
+	rs := s.RootModule().Resources["snyk_policy.test"]
+	return rs.Primary.Attributes["group_id"] + "/" + rs.Primary.ID, nil
+}
+
+func testAccExamplePolicyResourceConfig(groupId string, orgId string, policyType string, severity string) string {
+	return fmt.Sprintf(`
+resource "snyk_policy" "test" {
+  group_id = %[1]q
+  name = "Terraform acceptance test"
+  type = %[3]q
+  organization_ids = [%[2]q]
+  rules = [
+    {
+      name = "GPL"
+      conditions = {
+        license_types = ["GPL-3.0"]
+      }
+      action = {
+        type = "set_license_severity"
+        severity = %[4]q
+      }
+    },
+  ]
+}`, groupId, orgId, policyType, severity)
+}
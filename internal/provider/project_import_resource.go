@@ -0,0 +1,355 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/project"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+const (
+	// projectImportTimeout bounds how long Create waits for an import job.
+	projectImportTimeout = 30 * time.Minute
+	// projectImportPollInterval is the delay between two polls of an import job.
+	projectImportPollInterval = 5 * time.Second
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProjectImportResource{}
+
+func NewProjectImportResource() resource.Resource {
+	return &ProjectImportResource{}
+}
+
+// ProjectImportResource defines the resource implementation.
+type ProjectImportResource struct {
+	client snykclient.Client
+}
+
+// ProjectImportResourceModel describes the resource data model.
+type ProjectImportResourceModel struct {
+	Id             types.String              `tfsdk:"id"`
+	OrganizationId types.String              `tfsdk:"organization_id"`
+	IntegrationId  types.String              `tfsdk:"integration_id"`
+	Target         *ProjectImportTargetModel `tfsdk:"target"`
+	Files          types.Set                 `tfsdk:"files"`
+	ExclusionGlobs types.String              `tfsdk:"exclusion_globs"`
+	ProjectIds     types.List                `tfsdk:"project_ids"`
+}
+
+type ProjectImportTargetModel struct {
+	Owner  types.String `tfsdk:"owner"`
+	Name   types.String `tfsdk:"name"`
+	Branch types.String `tfsdk:"branch"`
+}
+
+func (r *ProjectImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_import"
+}
+
+func (r *ProjectImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "[Imports](https://docs.snyk.io/snyk-api/reference/import-projects-v1) a repository or an image into a Snyk organization through an integration. " +
+			"Create waits for the import job to complete, and destroying the resource deletes the projects it created. Any change re-imports the target, " +
+			"and so does the deletion of any of its projects outside of Terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Import job ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the integration to import through",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"files": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Paths of the manifest files to import. All the supported files are imported when not set.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"exclusion_globs": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comma separated list of the files and folders not to import, e.g. `fixtures, tests, __tests__, node_modules`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the projects created by the import",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"target": schema.SingleNestedBlock{
+				MarkdownDescription: "Repository or image to import",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"owner": schema.StringAttribute{
+						Optional:    true,
+						Description: "Owner of the repository, for SCM integrations",
+					},
+					"name": schema.StringAttribute{
+						Optional:    true,
+						Description: "Name of the repository, or of the image, e.g. `nginx:latest`",
+					},
+					"branch": schema.StringAttribute{
+						Optional:    true,
+						Description: "Branch of the repository. The default branch is imported when not set.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+func (r *ProjectImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ProjectImportResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse ProjectImport Organization Guid, got error: %s", err))
+		return
+	}
+
+	request, diags := prepareProjectImportRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgId := plan.OrganizationId.ValueString()
+	integrationId := plan.IntegrationId.ValueString()
+
+	jobId, err := r.client.ProjectClient.ImportProject(ctx, orgId, integrationId, request)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import Project, got error: %s", err))
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, projectImportTimeout)
+	defer cancel()
+
+	job, err := r.client.ProjectClient.WaitForImportJob(waitCtx, orgId, integrationId, jobId, projectImportPollInterval)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get ProjectImport job %s, got error: %s", jobId, err))
+
+		// The job may already have created projects, which are saved along
+		// with the tainted resource, so that they are deleted with it.
+		plan.Id = types.StringValue(jobId)
+		projectIds := []string{}
+		if job, err := r.client.ProjectClient.GetImportJob(ctx, orgId, integrationId, jobId); err == nil {
+			projectIds = job.ProjectIDs()
+		}
+		plan.ProjectIds, diags = types.ListValueFrom(ctx, types.StringType, projectIds)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	plan.Id = types.StringValue(job.ID)
+	projectIds, diags := types.ListValueFrom(ctx, types.StringType, job.ProjectIDs())
+	resp.Diagnostics.Append(diags...)
+	plan.ProjectIds = projectIds
+
+	// The projects created by a failed job are kept in the state, so that
+	// they are deleted along with the tainted resource.
+	if job.Status == project.IMPORT_STATUS_FAILED {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("ProjectImport job %s failed%s", job.ID, failedImports(job)))
+	} else if len(job.ProjectIDs()) == 0 {
+		resp.Diagnostics.AddWarning("No Project Imported", fmt.Sprintf("ProjectImport job %s did not create any project%s", job.ID, failedImports(job)))
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func prepareProjectImportRequest(ctx context.Context, plan *ProjectImportResourceModel) (*project.ImportRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if plan.Target == nil || plan.Target.Name.ValueString() == "" {
+		diags.AddError("Missing Attribute", "A target block with a name is required to import a project")
+		return nil, diags
+	}
+
+	request := &project.ImportRequest{
+		Target: project.ImportTarget{
+			Owner:  plan.Target.Owner.ValueString(),
+			Name:   plan.Target.Name.ValueString(),
+			Branch: plan.Target.Branch.ValueString(),
+		},
+		ExclusionGlobs: plan.ExclusionGlobs.ValueString(),
+	}
+
+	files, setDiags := stringSetPointer(ctx, plan.Files)
+	diags.Append(setDiags...)
+	if files != nil {
+		for _, file := range *files {
+			request.Files = append(request.Files, project.ImportFile{Path: file})
+		}
+	}
+
+	return request, diags
+}
+
+// failedImports describes the files which could not be imported by a job.
+func failedImports(job *project.ImportJob) string {
+	result := ""
+	for _, log := range job.Logs {
+		for _, p := range log.Projects {
+			if !p.Success {
+				result += fmt.Sprintf("\n- %s: %s", log.Name, p.TargetFile)
+			}
+		}
+	}
+	if result != "" {
+		result = ", the following files could not be imported:" + result
+	}
+	return result
+}
+
+func (r *ProjectImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProjectImportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectIds []string
+	resp.Diagnostics.Append(data.ProjectIds.ElementsAs(ctx, &projectIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An import job never changes once it is complete, but its projects may
+	// be deleted. The resource is then removed, so that the target is
+	// imported again.
+	for _, projectId := range projectIds {
+		_, err := r.client.ProjectClient.GetProject(ctx, data.OrganizationId.ValueString(), projectId)
+		if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Project %s, got error: %s", projectId, err))
+			return
+		}
+	}
+}
+
+func (r *ProjectImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the attributes require a replacement, so only the plan is saved.
+	var plan *ProjectImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProjectImportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectIds []string
+	resp.Diagnostics.Append(data.ProjectIds.ElementsAs(ctx, &projectIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, projectId := range projectIds {
+		err := r.client.ProjectClient.DeleteProject(ctx, data.OrganizationId.ValueString(), projectId)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Project %s, got error: %s", projectId, err))
+		}
+	}
+}
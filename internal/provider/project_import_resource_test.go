@@ -0,0 +1,57 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProjectImport(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	integrationId := readEnvVarOrSkip(t, "TEST_SNYK_INTEGRATION_ID")
+	repositoryOwner := readEnvVarOrSkip(t, "TEST_REPOSITORY_OWNER")
+	repositoryName := readEnvVarOrSkip(t, "TEST_REPOSITORY_NAME")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForProjectImport(snykOrgId, integrationId, repositoryOwner, repositoryName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snyk_project_import.test", "id"),
+					resource.TestCheckResourceAttrSet("snyk_project_import.test", "project_ids.0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExampleResourceConfigForProjectImport(orgId string, integrationId string, owner string, name string) string {
+	return fmt.Sprintf(`
+resource "snyk_project_import" "test" {
+  organization_id = %[1]q
+  integration_id = %[2]q
+  target {
+    owner = %[3]q
+    name = %[4]q
+  }
+}`, orgId, integrationId, owner, name)
+}
@@ -0,0 +1,503 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/project"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProjectResource{}
+var _ resource.ResourceWithImportState = &ProjectResource{}
+
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+// ProjectResource defines the resource implementation.
+type ProjectResource struct {
+	client snykclient.Client
+}
+
+// ProjectResourceModel describes the resource data model.
+type ProjectResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	OrganizationId      types.String `tfsdk:"organization_id"`
+	ProjectId           types.String `tfsdk:"project_id"`
+	TargetId            types.String `tfsdk:"target_id"`
+	TargetFile          types.String `tfsdk:"target_file"`
+	TargetReference     types.String `tfsdk:"target_reference"`
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	Origin              types.String `tfsdk:"origin"`
+	BusinessCriticality types.Set    `tfsdk:"business_criticality"`
+	Environment         types.Set    `tfsdk:"environment"`
+	Lifecycle           types.Set    `tfsdk:"project_lifecycle"`
+	Tags                types.Set    `tfsdk:"tags"`
+	OwnerId             types.String `tfsdk:"owner_id"`
+	TestFrequency       types.String `tfsdk:"test_frequency"`
+	Deactivated         types.Bool   `tfsdk:"deactivated"`
+}
+
+// ProjectTagModel describes a tag of a project.
+type ProjectTagModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+var projectTagAttrTypes = map[string]attr.Type{
+	"key":   types.StringType,
+	"value": types.StringType,
+}
+
+func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// projectAttributeSet is a set of strings among values, which keeps its
+// remote value when it is not configured.
+func projectAttributeSet(description string, values []string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: fmt.Sprintf("%s, among [%s]", description, strings.Join(values, ",")),
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(stringvalidator.OneOf(values...)),
+		},
+	}
+}
+
+func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the attributes, tags and settings of an existing Snyk [project](https://docs.snyk.io/snyk-admin/snyk-projects), " +
+			"identified either by its id or by its target and target file. Destroying this resource leaves the project unchanged.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Snyk Project ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the project to manage. Conflicts with target_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("target_id")),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the target of the project to manage",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"target_file": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Target file of the project to manage, e.g. `package.json`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("target_id")),
+				},
+			},
+			"target_reference": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Target reference of the project to manage, e.g. a branch",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("target_id")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Name of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Package manager of the project, e.g. `npm`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"origin": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Origin of the project, e.g. `github`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"business_criticality": projectAttributeSet("Business criticality of the project", project.BusinessCriticalities),
+			"environment":          projectAttributeSet("Environment of the project", project.Environments),
+			"project_lifecycle":    projectAttributeSet("Lifecycle of the project, `lifecycle` being reserved by Terraform", project.Lifecycles),
+			"tags": schema.SetNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Tags of the project",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:    true,
+							Description: "Key of the tag",
+						},
+						"value": schema.StringAttribute{
+							Required:    true,
+							Description: "Value of the tag",
+						},
+					},
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the user owning the project. The owner is left unchanged when not set, and removed when it is no longer set.",
+			},
+			"test_frequency": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("Frequency of the recurring tests of the project, one of [%s]", strings.Join(project.TestFrequencies, ",")),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(project.TestFrequencies...),
+				},
+			},
+			"deactivated": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the project is deactivated, i.e. neither tested nor monitored",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ProjectResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse Project Organization Guid, got error: %s", err))
+		return
+	}
+
+	projectId := plan.ProjectId.ValueString()
+	if plan.ProjectId.IsUnknown() || plan.ProjectId.IsNull() {
+		projectId, err = r.findProject(ctx, plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find Project, got error: %s", err))
+			return
+		}
+	}
+	plan.Id = types.StringValue(projectId)
+
+	resp.Diagnostics.Append(r.updateProject(ctx, plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findProject returns the id of the only project matching the target of plan.
+func (r *ProjectResource) findProject(ctx context.Context, plan *ProjectResourceModel) (string, error) {
+	projects, err := r.client.ProjectClient.ListProjects(ctx, plan.OrganizationId.ValueString(), project.ProjectFilter{
+		TargetID:        plan.TargetId.ValueString(),
+		TargetFile:      plan.TargetFile.ValueString(),
+		TargetReference: plan.TargetReference.ValueString(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	switch len(projects) {
+	case 0:
+		return "", fmt.Errorf("no project matches target %s", plan.TargetId.ValueString())
+	case 1:
+		return projects[0].ID, nil
+	default:
+		return "", fmt.Errorf("%d projects match target %s, set target_file or target_reference to select one", len(projects), plan.TargetId.ValueString())
+	}
+}
+
+// updateProject sends the configured attributes of plan which differ from
+// state, if any, and fills plan with the resulting project.
+func (r *ProjectResource) updateProject(ctx context.Context, plan *ProjectResourceModel, state *ProjectResourceModel) (diags diag.Diagnostics) {
+	orgId := plan.OrganizationId.ValueString()
+	projectId := plan.Id.ValueString()
+
+	update := &project.ProjectUpdate{
+		TestFrequency: stringPointer(plan.TestFrequency),
+	}
+
+	var setDiags diag.Diagnostics
+	update.BusinessCriticality, setDiags = stringSetPointer(ctx, plan.BusinessCriticality)
+	diags.Append(setDiags...)
+	update.Environment, setDiags = stringSetPointer(ctx, plan.Environment)
+	diags.Append(setDiags...)
+	update.Lifecycle, setDiags = stringSetPointer(ctx, plan.Lifecycle)
+	diags.Append(setDiags...)
+	update.Tags, setDiags = projectTagsPointer(ctx, plan.Tags)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	// The owner is only sent when configured, or to remove it when it no
+	// longer is.
+	if !plan.OwnerId.IsUnknown() && (!plan.OwnerId.IsNull() || (state != nil && !state.OwnerId.IsNull())) {
+		ownerId := plan.OwnerId.ValueString()
+		update.OwnerID = &ownerId
+	}
+
+	_, err := r.client.ProjectClient.UpdateProject(ctx, orgId, projectId, update)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update Project, got error: %s", err))
+		return
+	}
+
+	if !plan.Deactivated.IsUnknown() && !plan.Deactivated.IsNull() && (state == nil || !state.Deactivated.Equal(plan.Deactivated)) {
+		if plan.Deactivated.ValueBool() {
+			err = r.client.ProjectClient.DeactivateProject(ctx, orgId, projectId)
+		} else {
+			err = r.client.ProjectClient.ActivateProject(ctx, orgId, projectId)
+		}
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to change the status of Project, got error: %s", err))
+			return
+		}
+	}
+
+	res, err := r.client.ProjectClient.GetProject(ctx, orgId, projectId)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get Project, got error: %s", err))
+		return
+	}
+
+	diags.Append(convertProjectRemoteData2Local(ctx, plan, res)...)
+	return
+}
+
+func convertProjectRemoteData2Local(ctx context.Context, data *ProjectResourceModel, res *project.Project) (diags diag.Diagnostics) {
+	data.Id = types.StringValue(res.ID)
+	data.ProjectId = types.StringValue(res.ID)
+	data.TargetId = types.StringValue(res.TargetID)
+	data.TargetFile = types.StringValue(res.TargetFile)
+	data.TargetReference = types.StringValue(res.TargetReference)
+	data.Name = types.StringValue(res.Name)
+	data.Type = types.StringValue(res.Type)
+	data.Origin = types.StringValue(res.Origin)
+	data.TestFrequency = types.StringValue(res.TestFrequency)
+	data.Deactivated = types.BoolValue(res.Status == project.STATUS_INACTIVE)
+
+	// The owner is only tracked when configured, so that adopting a project
+	// keeps its owner.
+	if !data.OwnerId.IsNull() {
+		data.OwnerId = types.StringNull()
+		if res.OwnerID != "" {
+			data.OwnerId = types.StringValue(res.OwnerID)
+		}
+	}
+
+	var setDiags diag.Diagnostics
+	data.BusinessCriticality, setDiags = types.SetValueFrom(ctx, types.StringType, res.BusinessCriticality)
+	diags.Append(setDiags...)
+	data.Environment, setDiags = types.SetValueFrom(ctx, types.StringType, res.Environment)
+	diags.Append(setDiags...)
+	data.Lifecycle, setDiags = types.SetValueFrom(ctx, types.StringType, res.Lifecycle)
+	diags.Append(setDiags...)
+	data.Tags, setDiags = projectTagsValue(ctx, res.Tags)
+	diags.Append(setDiags...)
+	return
+}
+
+func projectTagsPointer(ctx context.Context, value types.Set) (*[]project.Tag, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	var tags []ProjectTagModel
+	diags := value.ElementsAs(ctx, &tags, false)
+	result := []project.Tag{}
+	for _, tag := range tags {
+		result = append(result, project.Tag{Key: tag.Key.ValueString(), Value: tag.Value.ValueString()})
+	}
+	return &result, diags
+}
+
+func projectTagsValue(ctx context.Context, tags []project.Tag) (types.Set, diag.Diagnostics) {
+	models := []ProjectTagModel{}
+	for _, tag := range tags {
+		models = append(models, ProjectTagModel{Key: types.StringValue(tag.Key), Value: types.StringValue(tag.Value)})
+	}
+	return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: projectTagAttrTypes}, models)
+}
+
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProjectResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.ProjectClient.GetProject(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Project, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(convertProjectRemoteData2Local(ctx, data, res)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state *ProjectResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	resp.Diagnostics.Append(r.updateProject(ctx, plan, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The project is not managed by this resource, it is only removed from
+	// the state
+}
+
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, projectId, found := strings.Cut(req.ID, "/")
+	if !found || organizationId == "" || projectId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format organization_id/project_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectId)...)
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProject(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	projectId := readEnvVarOrSkip(t, "TEST_SNYK_PROJECT_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForProject(snykOrgId, projectId, "high", "production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_project.test", "id", projectId),
+					resource.TestCheckResourceAttr("snyk_project.test", "business_criticality.#", "1"),
+					resource.TestCheckTypeSetElemAttr("snyk_project.test", "business_criticality.*", "high"),
+					resource.TestCheckTypeSetElemNestedAttrs("snyk_project.test", "tags.*", map[string]string{"key": "terraform", "value": "production"}),
+					resource.TestCheckResourceAttrPair("data.snyk_projects.test", "projects.0.id", "snyk_project.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_project.test",
+				ImportState:       true,
+				ImportStateId:     snykOrgId + "/" + projectId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForProject(snykOrgId, projectId, "low", "sandbox"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("snyk_project.test", "business_criticality.*", "low"),
+					resource.TestCheckTypeSetElemNestedAttrs("snyk_project.test", "tags.*", map[string]string{"key": "terraform", "value": "sandbox"}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExampleResourceConfigForProject(orgId string, projectId string, criticality string, tag string) string {
+	return fmt.Sprintf(`
+resource "snyk_project" "test" {
+  organization_id = %[1]q
+  project_id = %[2]q
+  business_criticality = [%[3]q]
+  tags = [{ key = "terraform", value = %[4]q }]
+}
+
+data "snyk_projects" "test" {
+  organization_id = snyk_project.test.organization_id
+  target_id = snyk_project.test.target_id
+  target_file = snyk_project.test.target_file
+  tags = [{ key = "terraform", value = %[4]q }]
+}`, orgId, projectId, criticality, tag)
+}
+
+func TestAccProjectOwner(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	projectId := readEnvVarOrSkip(t, "TEST_SNYK_PROJECT_ID")
+	ownerId := readEnvVarOrSkip(t, "TEST_SNYK_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Adopt the project, leaving its owner unchanged
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForProjectOwner(snykOrgId, projectId, ""),
+				Check: resource.TestCheckNoResourceAttr("snyk_project.test", "owner_id"),
+			},
+			// Set the owner
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForProjectOwner(snykOrgId, projectId, fmt.Sprintf("owner_id = %q", ownerId)),
+				Check: resource.TestCheckResourceAttr("snyk_project.test", "owner_id", ownerId),
+			},
+			// Clear the owner
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForProjectOwner(snykOrgId, projectId, ""),
+				Check: resource.TestCheckNoResourceAttr("snyk_project.test", "owner_id"),
+			},
+		},
+	})
+}
+
+func testAccExampleResourceConfigForProjectOwner(orgId string, projectId string, owner string) string {
+	return fmt.Sprintf(`
+resource "snyk_project" "test" {
+  organization_id = %[1]q
+  project_id = %[2]q
+  %[3]s
+}`, orgId, projectId, owner)
+}
@@ -0,0 +1,581 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/project"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ProjectTagsResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectTagsResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectTagsResource{}
+
+// ownedProjectTagsKey is the private state key of the tags added by the
+// resource, which are the only ones it removes.
+const ownedProjectTagsKey = "owned_tags"
+
+func NewProjectTagsResource() resource.Resource {
+	return &ProjectTagsResource{}
+}
+
+// ProjectTagsResource defines the resource implementation.
+type ProjectTagsResource struct {
+	client snykclient.Client
+}
+
+// ProjectTagsResourceModel describes the resource data model.
+type ProjectTagsResourceModel struct {
+	Id             types.String          `tfsdk:"id"`
+	OrganizationId types.String          `tfsdk:"organization_id"`
+	Selector       *ProjectSelectorModel `tfsdk:"selector"`
+	Tags           types.Set             `tfsdk:"tags"`
+	ProjectIds     types.Set             `tfsdk:"project_ids"`
+}
+
+// ProjectSelectorModel describes the criteria of a set of projects.
+type ProjectSelectorModel struct {
+	TargetId  types.String `tfsdk:"target_id"`
+	Origins   types.Set    `tfsdk:"origins"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Tags      types.Set    `tfsdk:"tags"`
+}
+
+// ownedProjectTag is a tag added to a project by the resource.
+type ownedProjectTag struct {
+	ProjectID string `json:"project_id"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+}
+
+func (r *ProjectTagsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_tags"
+}
+
+// projectTagsAttribute returns a required set of tags.
+func projectTagsAttribute(required bool, description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Required:            required,
+		Optional:            !required,
+		MarkdownDescription: description,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"key": schema.StringAttribute{
+					Required:    true,
+					Description: "Key of the tag",
+				},
+				"value": schema.StringAttribute{
+					Required:    true,
+					Description: "Value of the tag",
+				},
+			},
+		},
+	}
+}
+
+// projectSelectorAttribute returns the schema of a ProjectSelectorModel.
+func projectSelectorAttribute(required bool, description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Required:            required,
+		Optional:            !required,
+		Attributes: map[string]schema.Attribute{
+			"target_id": schema.StringAttribute{
+				MarkdownDescription: "Only match the projects of this target",
+				Optional:            true,
+			},
+			"origins": schema.SetAttribute{
+				MarkdownDescription: "Only match the projects with one of these origins, e.g. `github`",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only match the projects whose name matches this [regular expression](https://pkg.go.dev/regexp/syntax)",
+				Optional:            true,
+			},
+			"tags": projectTagsAttribute(false, "Only match the projects with all these tags"),
+		},
+	}
+}
+
+func (r *ProjectTagsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Applies tags to all the projects of a Snyk organization matching a selector, including the projects matching it after creation, on the next apply. " +
+			"Destroying this resource only removes the tags it added, and not the ones the projects already had. This resource cannot be imported, as the tags it added are not known.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Random ID of the resource",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"selector": projectSelectorAttribute(true, "Criteria the projects must all match. All the projects of the organization are tagged when no criteria is set."),
+			"tags":     projectTagsAttribute(true, "Tags applied to the matching projects"),
+			"project_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the tagged projects",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectTagsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateProjectSelector(ctx, req.Config, path.Root("selector"))...)
+}
+
+// validateProjectSelector checks the name_regex of the selector at p, if
+// set.
+func validateProjectSelector(ctx context.Context, config tfsdk.Config, p path.Path) diag.Diagnostics {
+	var nameRegex types.String
+
+	diags := config.GetAttribute(ctx, p.AtName("name_regex"), &nameRegex)
+	if diags.HasError() || nameRegex.IsNull() || nameRegex.IsUnknown() {
+		return diags
+	}
+
+	if _, err := regexp.Compile(nameRegex.ValueString()); err != nil {
+		diags.AddAttributeError(p.AtName("name_regex"), "Invalid Attribute Value", fmt.Sprintf("name_regex must be a regular expression, got error: %s", err))
+	}
+	return diags
+}
+
+func (r *ProjectTagsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+// selectProjects returns the projects of the organization orgID matching
+// selector.
+func selectProjects(ctx context.Context, client snykclient.Client, orgID string, selector *ProjectSelectorModel) ([]*project.Project, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter := project.ProjectFilter{
+		TargetID: selector.TargetId.ValueString(),
+	}
+	diags.Append(stringSetElements(ctx, selector.Origins, &filter.Origins)...)
+	if tags, d := projectTagsPointer(ctx, selector.Tags); tags != nil {
+		filter.Tags = *tags
+	} else {
+		diags.Append(d...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	nameRegex, err := regexp.Compile(selector.NameRegex.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("selector").AtName("name_regex"), "Invalid Attribute Value", fmt.Sprintf("name_regex must be a regular expression, got error: %s", err))
+		return nil, diags
+	}
+
+	res, err := client.ProjectClient.ListProjects(ctx, orgID, filter)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list Projects, got error: %s", err))
+		return nil, diags
+	}
+
+	projects := []*project.Project{}
+	for _, p := range res {
+		if nameRegex.MatchString(p.Name) {
+			projects = append(projects, p)
+		}
+	}
+
+	return projects, diags
+}
+
+func hasProjectTag(tags []project.Tag, tag project.Tag) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// projectIdsValue returns the IDs of projects as a set.
+func projectIdsValue(ctx context.Context, projects []*project.Project) (types.Set, diag.Diagnostics) {
+	ids := []string{}
+	for _, p := range projects {
+		ids = append(ids, p.ID)
+	}
+	sort.Strings(ids)
+	return types.SetValueFrom(ctx, types.StringType, ids)
+}
+
+// removeProjectTags removes tags from the project projectID, if it still
+// exists.
+func (r *ProjectTagsResource) removeProjectTags(ctx context.Context, orgID string, projectID string, tags []project.Tag) error {
+	p, err := r.client.ProjectClient.GetProject(ctx, orgID, projectID)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	remaining := []project.Tag{}
+	for _, tag := range p.Tags {
+		if !hasProjectTag(tags, tag) {
+			remaining = append(remaining, tag)
+		}
+	}
+	if len(remaining) == len(p.Tags) {
+		return nil
+	}
+
+	_, err = r.client.ProjectClient.UpdateProject(ctx, orgID, projectID, &project.ProjectUpdate{Tags: &remaining})
+	return err
+}
+
+// apply adds the tags of data to the matching projects, and removes the
+// tags in owned which no longer apply. It returns the tags the resource owns
+// afterwards, and sets the tagged projects in data.
+func (r *ProjectTagsResource) apply(ctx context.Context, data *ProjectTagsResourceModel, owned []ownedProjectTag) ([]ownedProjectTag, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	orgID := data.OrganizationId.ValueString()
+
+	desired, d := projectTagsPointer(ctx, data.Tags)
+	diags.Append(d...)
+	projects, d := selectProjects(ctx, r.client, data.OrganizationId.ValueString(), data.Selector)
+	diags.Append(d...)
+	if diags.HasError() {
+		return owned, diags
+	}
+
+	isOwned := map[ownedProjectTag]bool{}
+	for _, o := range owned {
+		isOwned[o] = true
+	}
+
+	newOwned := []ownedProjectTag{}
+	matched := map[string]bool{}
+	for _, p := range projects {
+		matched[p.ID] = true
+
+		// Remove the owned tags which are no longer desired, and add the
+		// desired tags the project does not have yet.
+		changed := false
+		tags := []project.Tag{}
+		for _, tag := range p.Tags {
+			if isOwned[ownedProjectTag{p.ID, tag.Key, tag.Value}] && !hasProjectTag(*desired, tag) {
+				changed = true
+				continue
+			}
+			tags = append(tags, tag)
+		}
+		for _, tag := range *desired {
+			o := ownedProjectTag{p.ID, tag.Key, tag.Value}
+			if !hasProjectTag(tags, tag) {
+				tags = append(tags, tag)
+				newOwned = append(newOwned, o)
+				changed = true
+			} else if isOwned[o] {
+				newOwned = append(newOwned, o)
+			}
+		}
+
+		if changed {
+			if _, err := r.client.ProjectClient.UpdateProject(ctx, orgID, p.ID, &project.ProjectUpdate{Tags: &tags}); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to tag Project %s, got error: %s", p.ID, err))
+				// Keep the ownership of the tags which may not have been removed.
+				return append(newOwned, owned...), diags
+			}
+		}
+	}
+
+	// Remove the owned tags of the projects which no longer match.
+	unmatched := map[string][]project.Tag{}
+	for _, o := range owned {
+		if !matched[o.ProjectID] {
+			unmatched[o.ProjectID] = append(unmatched[o.ProjectID], project.Tag{Key: o.Key, Value: o.Value})
+		}
+	}
+	for projectID, tags := range unmatched {
+		if err := r.removeProjectTags(ctx, orgID, projectID, tags); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to untag Project %s, got error: %s", projectID, err))
+			return append(newOwned, owned...), diags
+		}
+	}
+
+	data.ProjectIds, d = projectIdsValue(ctx, projects)
+	diags.Append(d...)
+
+	return newOwned, diags
+}
+
+// getOwnedProjectTags returns the tags the resource owns, from its private
+// state.
+func getOwnedProjectTags(ctx context.Context, getKey func(context.Context, string) ([]byte, diag.Diagnostics)) ([]ownedProjectTag, diag.Diagnostics) {
+	value, diags := getKey(ctx, ownedProjectTagsKey)
+	owned := []ownedProjectTag{}
+	if diags.HasError() || len(value) == 0 {
+		return owned, diags
+	}
+	if err := json.Unmarshal(value, &owned); err != nil {
+		diags.AddError("Invalid Private State", fmt.Sprintf("Unable to decode the tags owned by the resource, got error: %s", err))
+	}
+	return owned, diags
+}
+
+// setOwnedProjectTags saves the tags the resource owns in its private state.
+func setOwnedProjectTags(ctx context.Context, setKey func(context.Context, string, []byte) diag.Diagnostics, owned []ownedProjectTag) diag.Diagnostics {
+	value, err := json.Marshal(owned)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Invalid Private State", fmt.Sprintf("Unable to encode the tags owned by the resource, got error: %s", err))
+		return diags
+	}
+	return setKey(ctx, ownedProjectTagsKey, value)
+}
+
+func (r *ProjectTagsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only updates are checked, as all the matching projects are tagged on
+	// creation.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *ProjectTagsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateSelector, planSelector types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("selector"), &stateSelector)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("selector"), &planSelector)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed := !planSelector.Equal(stateSelector) || !plan.Tags.Equal(state.Tags)
+	if !changed && !plan.OrganizationId.IsUnknown() {
+		// Look for projects which started matching, or lost their tags, since
+		// the last apply.
+		projects, diags := selectProjects(ctx, r.client, plan.OrganizationId.ValueString(), plan.Selector)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		desired, diags := projectTagsPointer(ctx, plan.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, p := range projects {
+			for _, tag := range *desired {
+				if !hasProjectTag(p.Tags, tag) {
+					changed = true
+				}
+			}
+		}
+	}
+
+	if changed {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("project_ids"), types.SetUnknown(types.StringType))...)
+	}
+}
+
+func (r *ProjectTagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *ProjectTagsResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse ProjectTags Organization Guid, got error: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(uuid.NewString())
+
+	owned, diags := r.apply(ctx, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	// Save the ownership of the tags added before an error, along with the
+	// state, so that the tainted resource removes them on destroy.
+	resp.Diagnostics.Append(setOwnedProjectTags(ctx, resp.Private.SetKey, owned)...)
+	if resp.Diagnostics.HasError() {
+		if plan.ProjectIds.IsUnknown() {
+			plan.ProjectIds, _ = projectIdsValue(ctx, nil)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ProjectTagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ProjectTagsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, diags := selectProjects(ctx, r.client, data.OrganizationId.ValueString(), data.Selector)
+	resp.Diagnostics.Append(diags...)
+	desired, diags := projectTagsPointer(ctx, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the projects with all the tags are tagged.
+	tagged := []*project.Project{}
+	for _, p := range projects {
+		all := true
+		for _, tag := range *desired {
+			all = all && hasProjectTag(p.Tags, tag)
+		}
+		if all {
+			tagged = append(tagged, p)
+		}
+	}
+
+	data.ProjectIds, diags = projectIdsValue(ctx, tagged)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectTagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *ProjectTagsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned, diags := getOwnedProjectTags(ctx, req.Private.GetKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned, diags = r.apply(ctx, plan, owned)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setOwnedProjectTags(ctx, resp.Private.SetKey, owned)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectTagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ProjectTagsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned, diags := getOwnedProjectTags(ctx, req.Private.GetKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := map[string][]project.Tag{}
+	for _, o := range owned {
+		tags[o.ProjectID] = append(tags[o.ProjectID], project.Tag{Key: o.Key, Value: o.Value})
+	}
+	for projectID, projectTags := range tags {
+		if err := r.removeProjectTags(ctx, data.OrganizationId.ValueString(), projectID, projectTags); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to untag Project %s, got error: %s", projectID, err))
+			return
+		}
+	}
+}
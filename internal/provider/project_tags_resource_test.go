@@ -0,0 +1,70 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProjectTags(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	targetId := readEnvVarOrSkip(t, "TEST_SNYK_TARGET_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForProjectTags(snykOrgId, targetId, "production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snyk_project_tags.test", "id"),
+					resource.TestCheckResourceAttrPair("snyk_project_tags.test", "project_ids.#", "data.snyk_projects.test", "projects.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snyk_projects.test", "projects.0.tags.*", map[string]string{"key": "terraform-bulk", "value": "production"}),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForProjectTags(snykOrgId, targetId, "sandbox"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("snyk_project_tags.test", "project_ids.#", "data.snyk_projects.test", "projects.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snyk_projects.test", "projects.0.tags.*", map[string]string{"key": "terraform-bulk", "value": "sandbox"}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExampleResourceConfigForProjectTags(orgId string, targetId string, tag string) string {
+	return fmt.Sprintf(`
+resource "snyk_project_tags" "test" {
+  organization_id = %[1]q
+  selector = {
+    target_id = %[2]q
+  }
+  tags = [{ key = "terraform-bulk", value = %[3]q }]
+}
+
+data "snyk_projects" "test" {
+  organization_id = snyk_project_tags.test.organization_id
+  target_id = %[2]q
+  tags = [{ key = "terraform-bulk", value = %[3]q }]
+}`, orgId, targetId, tag)
+}
@@ -0,0 +1,289 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/project"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ProjectsDataSource{}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &ProjectsDataSource{}
+}
+
+// ProjectsDataSource defines the data source implementation.
+type ProjectsDataSource struct {
+	client snykclient.Client
+}
+
+// ProjectsDataSourceModel describes the data source data model.
+type ProjectsDataSourceModel struct {
+	Id              types.String                     `tfsdk:"id"`
+	OrganizationId  types.String                     `tfsdk:"organization_id"`
+	TargetId        types.String                     `tfsdk:"target_id"`
+	TargetFile      types.String                     `tfsdk:"target_file"`
+	TargetReference types.String                     `tfsdk:"target_reference"`
+	Names           types.Set                        `tfsdk:"names"`
+	Origins         types.Set                        `tfsdk:"origins"`
+	Types           types.Set                        `tfsdk:"types"`
+	Tags            types.Set                        `tfsdk:"tags"`
+	Projects        []ProjectsDataSourceProjectModel `tfsdk:"projects"`
+}
+
+type ProjectsDataSourceProjectModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	TargetId            types.String `tfsdk:"target_id"`
+	TargetFile          types.String `tfsdk:"target_file"`
+	TargetReference     types.String `tfsdk:"target_reference"`
+	Origin              types.String `tfsdk:"origin"`
+	Status              types.String `tfsdk:"status"`
+	BusinessCriticality types.Set    `tfsdk:"business_criticality"`
+	Environment         types.Set    `tfsdk:"environment"`
+	Lifecycle           types.Set    `tfsdk:"project_lifecycle"`
+	Tags                types.Set    `tfsdk:"tags"`
+}
+
+func (d *ProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func projectTagsDataSourceAttribute(required bool, description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Optional:            !required,
+		Computed:            required,
+		MarkdownDescription: description,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"key": schema.StringAttribute{
+					Required:    !required,
+					Computed:    required,
+					Description: "Key of the tag",
+				},
+				"value": schema.StringAttribute{
+					Required:    !required,
+					Computed:    required,
+					Description: "Value of the tag",
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Provides the [projects](https://docs.snyk.io/snyk-admin/snyk-projects) of a Snyk organization, optionally filtered",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as organization_id",
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+			},
+			"target_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the projects of this target",
+			},
+			"target_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the projects with this target file",
+			},
+			"target_reference": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the projects with this target reference",
+			},
+			"names": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return the projects with one of these names",
+			},
+			"origins": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return the projects with one of these origins, e.g. `github`",
+			},
+			"types": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return the projects with one of these types, e.g. `npm`",
+			},
+			"tags": projectTagsDataSourceAttribute(false, "Only return the projects with all these tags"),
+			"projects": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching projects",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Snyk Project ID",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the project",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Package manager of the project",
+						},
+						"target_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the target of the project",
+						},
+						"target_file": schema.StringAttribute{
+							Computed:    true,
+							Description: "Target file of the project",
+						},
+						"target_reference": schema.StringAttribute{
+							Computed:    true,
+							Description: "Target reference of the project",
+						},
+						"origin": schema.StringAttribute{
+							Computed:    true,
+							Description: "Origin of the project",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "One of [active,inactive]",
+						},
+						"business_criticality": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Business criticality of the project",
+						},
+						"environment": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Environment of the project",
+						},
+						"project_lifecycle": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Lifecycle of the project",
+						},
+						"tags": projectTagsDataSourceAttribute(true, "Tags of the project"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *ProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diags := prepareProjectFilter(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.ProjectClient.ListProjects(ctx, data.OrganizationId.ValueString(), filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Projects, got error: %s", err))
+		return
+	}
+
+	data.Id = data.OrganizationId
+	data.Projects = []ProjectsDataSourceProjectModel{}
+	for _, p := range res {
+		model := ProjectsDataSourceProjectModel{
+			Id:              types.StringValue(p.ID),
+			Name:            types.StringValue(p.Name),
+			Type:            types.StringValue(p.Type),
+			TargetId:        types.StringValue(p.TargetID),
+			TargetFile:      types.StringValue(p.TargetFile),
+			TargetReference: types.StringValue(p.TargetReference),
+			Origin:          types.StringValue(p.Origin),
+			Status:          types.StringValue(p.Status),
+		}
+
+		var setDiags diag.Diagnostics
+		model.BusinessCriticality, setDiags = types.SetValueFrom(ctx, types.StringType, p.BusinessCriticality)
+		resp.Diagnostics.Append(setDiags...)
+		model.Environment, setDiags = types.SetValueFrom(ctx, types.StringType, p.Environment)
+		resp.Diagnostics.Append(setDiags...)
+		model.Lifecycle, setDiags = types.SetValueFrom(ctx, types.StringType, p.Lifecycle)
+		resp.Diagnostics.Append(setDiags...)
+		model.Tags, setDiags = projectTagsValue(ctx, p.Tags)
+		resp.Diagnostics.Append(setDiags...)
+
+		data.Projects = append(data.Projects, model)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func prepareProjectFilter(ctx context.Context, data *ProjectsDataSourceModel) (filter project.ProjectFilter, diags diag.Diagnostics) {
+	filter.TargetID = data.TargetId.ValueString()
+	filter.TargetFile = data.TargetFile.ValueString()
+	filter.TargetReference = data.TargetReference.ValueString()
+
+	diags.Append(stringSetElements(ctx, data.Names, &filter.Names)...)
+	diags.Append(stringSetElements(ctx, data.Origins, &filter.Origins)...)
+	diags.Append(stringSetElements(ctx, data.Types, &filter.Types)...)
+
+	tags, tagDiags := projectTagsPointer(ctx, data.Tags)
+	diags.Append(tagDiags...)
+	if tags != nil {
+		filter.Tags = *tags
+	}
+	return
+}
@@ -17,19 +17,29 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
 	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykconfig"
 )
 
 const DefaultEndpoint = "https://api.snyk.io/rest"
 
 // Ensure SnykProvider satisfies various provider interfaces.
 var _ provider.Provider = &SnykProvider{}
+var _ provider.ProviderWithFunctions = &SnykProvider{}
 
 // SnykProvider defines the provider implementation.
 type SnykProvider struct {
@@ -41,8 +51,16 @@ type SnykProvider struct {
 
 // SnykProviderModel describes the provider data model.
 type SnykProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	ApiToken types.String `tfsdk:"api_token"`
+	Endpoint                  types.String `tfsdk:"endpoint"`
+	ApiToken                  types.String `tfsdk:"api_token"`
+	SkipCredentialsValidation types.Bool   `tfsdk:"skip_credentials_validation"`
+	ProxyURL                  types.String `tfsdk:"proxy_url"`
+	NoProxy                   types.String `tfsdk:"no_proxy"`
+	CACertFile                types.String `tfsdk:"ca_cert_file"`
+	CACertPEM                 types.String `tfsdk:"ca_cert_pem"`
+	ClientCertFile            types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile             types.String `tfsdk:"client_key_file"`
+	InsecureSkipVerify        types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 func (p *SnykProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -58,10 +76,48 @@ func (p *SnykProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				Optional:            true,
 			},
 			"api_token": schema.StringAttribute{
-				MarkdownDescription: "API token ",
+				MarkdownDescription: "API token. Defaults to the `SNYK_TOKEN` environment variable, then to the credentials stored by `snyk auth` in the Snyk CLI configuration file (`SNYK_CFG_*` environment variables override its values), where an API token takes precedence over an OAuth token.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Skip the validation of the credentials against the `/self` API endpoint when the provider is configured. Defaults to `false`.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to send the API requests through. Defaults to the `HTTPS_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"no_proxy": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated list of hosts which are not reached through the proxy, with the syntax of the `NO_PROXY` environment variable. Defaults to the `NO_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM file of certificate authorities to trust in addition to the system ones.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate authorities to trust in addition to the system ones.",
+				Optional:            true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the PEM encoded client certificate used for mutual TLS. Requires `client_key_file`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_file")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the PEM encoded private key of the client certificate used for mutual TLS. Requires `client_cert_file`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_file")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the TLS certificate of the API. Only meant for test installations. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -80,28 +136,133 @@ func (p *SnykProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		endpoint = data.Endpoint.ValueString()
 	}
 
-	client, err := snykclient.NewClient(endpoint, data.ApiToken.ValueString())
+	config := snykclient.Config{
+		URL:   endpoint,
+		Token: data.ApiToken.ValueString(),
+		HTTPOptions: []snyk_http.Option{
+			snyk_http.WithProxy(data.ProxyURL.ValueString(), data.NoProxy.ValueString()),
+			snyk_http.WithExtraCertificates(data.CACertFile.ValueString()),
+			snyk_http.WithExtraCertificatesPEM(data.CACertPEM.ValueString()),
+			snyk_http.WithClientCertificate(data.ClientCertFile.ValueString(), data.ClientKeyFile.ValueString()),
+			snyk_http.WithInsecureSkipVerify(data.InsecureSkipVerify.ValueBool()),
+		},
+	}
+	if config.Token == "" {
+		config.Token = os.Getenv("SNYK_TOKEN")
+	}
+	if config.Token == "" {
+		credentials, err := snykconfig.LoadCredentials()
+		if err != nil {
+			resp.Diagnostics.AddError("Configuration Error", fmt.Sprintf("Unable to read Snyk CLI credentials: %s", err))
+			return
+		}
+		if credentials.Warning != "" {
+			resp.Diagnostics.AddWarning("Snyk CLI Credentials Ignored", credentials.Warning)
+		}
+		config.Token = credentials.Token
+		config.BearerToken = credentials.BearerToken
+	}
+
+	client, err := snykclient.NewClient(config)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create rest client: %s", err))
 		return
 	}
 
+	// The credentials cannot be checked while they are still unknown, e.g.
+	// when they depend on a resource which has not been created yet.
+	if !data.SkipCredentialsValidation.ValueBool() && !data.ApiToken.IsUnknown() && !data.Endpoint.IsUnknown() {
+		resp.Diagnostics.Append(validateCredentials(ctx, client, endpoint)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 
 }
 
+// validateCredentials checks that the Snyk API accepts the credentials of the
+// client, and records the principal they belong to.
+func validateCredentials(ctx context.Context, client *snykclient.Client, endpoint string) (diags diag.Diagnostics) {
+	self, err := client.UserClient.GetSelf(ctx)
+	if snyk_http.HasStatusCode(err, http.StatusUnauthorized) {
+		diags.AddError(
+			"Invalid Credentials",
+			fmt.Sprintf("The Snyk API at %s rejected the API token. The token may be invalid or expired, "+
+				"or it may belong to another Snyk region, in which case the endpoint should be set to the API URL of that region. "+
+				"Set skip_credentials_validation to true to skip this check.", endpoint),
+		)
+		return
+	}
+	if snyk_http.HasStatusCode(err, http.StatusForbidden) {
+		diags.AddError(
+			"Insufficient Credentials",
+			fmt.Sprintf("The Snyk API at %s accepted the API token, but denied it access to its own user or service account. "+
+				"The token may lack the required permissions, or the account may be disabled. "+
+				"Set skip_credentials_validation to true to skip this check.", endpoint),
+		)
+		return
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to validate credentials, got error: %s", err))
+		return
+	}
+
+	client.Self = self
+	return
+}
+
 func (p *SnykProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewEnvironmentResource,
 		NewOrganizationResource,
 		NewOrganizationServiceAccountResource,
+		NewIntegrationResource,
+		NewIntegrationSettingsResource,
+		NewProjectImportResource,
+		NewProjectResource,
+		NewTargetResource,
+		NewOrganizationSettingsResource,
+		NewIacSettingsResource,
+		NewSastSettingsResource,
+		NewOrganizationMembershipResource,
+		NewGroupMembershipResource,
+		NewOrganizationInviteResource,
+		NewCustomRoleResource,
+		NewPolicyResource,
+		NewIgnoreResource,
+		NewWebhookResource,
+		NewProjectTagsResource,
+		NewCollectionResource,
+		NewNotificationSettingsResource,
+		NewBrokerDeploymentResource,
+		NewBrokerConnectionResource,
+		NewBrokerConnectionIntegrationResource,
 	}
 }
 
 func (p *SnykProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewSelfDataSource,
+		NewIntegrationsDataSource,
+		NewProjectsDataSource,
+		NewTargetsDataSource,
+		NewOrganizationMembersDataSource,
+		NewGroupMembersDataSource,
+		NewOrganizationInvitesDataSource,
+		NewRoleDataSource,
+		NewRolesDataSource,
+		NewCollectionsDataSource,
+	}
+}
+
+func (p *SnykProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewWebhookSignatureFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,48 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+func TestValidateCredentials(t *testing.T) {
+	for status, summary := range map[int]string{
+		http.StatusUnauthorized:        "Invalid Credentials",
+		http.StatusForbidden:           "Insufficient Credentials",
+		http.StatusInternalServerError: "Client Error",
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+
+		client, err := snykclient.NewClient(snykclient.Config{URL: server.URL, Token: "token"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		diags := validateCredentials(context.Background(), client, server.URL)
+		if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != summary {
+			t.Errorf("expected a %q error for status %d, got %v", summary, status, diags)
+		}
+
+		server.Close()
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/role"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &RoleDataSource{}
+
+func NewRoleDataSource() datasource.DataSource {
+	return &RoleDataSource{}
+}
+
+// RoleDataSource defines the data source implementation.
+type RoleDataSource struct {
+	client snykclient.Client
+}
+
+// RoleDataSourceModel describes the data source data model.
+type RoleDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	GroupId        types.String `tfsdk:"group_id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Name           types.String `tfsdk:"name"`
+	Scope          types.String `tfsdk:"scope"`
+	Description    types.String `tfsdk:"description"`
+	Permissions    types.Set    `tfsdk:"permissions"`
+	Custom         types.Bool   `tfsdk:"custom"`
+}
+
+func (d *RoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (d *RoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := rolesScopeAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Role ID",
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the role, such as `Org Admin` or `Org Collaborator`",
+		Required:            true,
+	}
+	attributes["scope"] = schema.StringAttribute{
+		MarkdownDescription: "Whether the role is given to members of organizations, `org`, or of the group, `group`. " +
+			"Only needed to tell apart roles of both scopes with the same name.",
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(role.SCOPE_ORG, role.SCOPE_GROUP),
+		},
+	}
+	attributes["description"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Description of the role",
+	}
+	attributes["permissions"] = schema.SetAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Permissions given by the role",
+	}
+	attributes["custom"] = schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Whether the role is a custom role, rather than a built-in one",
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up a built-in or custom [member role](https://docs.snyk.io/snyk-admin/manage-users-and-permissions/member-roles) of a Snyk group by name, " +
+			"for instance to get the role_id of memberships and service accounts",
+
+		Attributes: attributes,
+	}
+}
+
+func (d *RoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RoleDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, diags := listRoles(ctx, d.client, data.GroupId, data.OrganizationId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	matches := []*role.Role{}
+	for _, r := range res {
+		if r.Name == data.Name.ValueString() && (data.Scope.IsNull() || r.Scope == data.Scope.ValueString()) {
+			matches = append(matches, r)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Role Not Found", fmt.Sprintf("No role is named %q", data.Name.ValueString()))
+		return
+	}
+	if len(matches) > 1 {
+		ids := []string{}
+		for _, r := range matches {
+			ids = append(ids, fmt.Sprintf("%s (%s)", r.ID, r.Scope))
+		}
+		detail := fmt.Sprintf("%d roles are named %q: %s.", len(matches), data.Name.ValueString(), strings.Join(ids, ", "))
+		if data.Scope.IsNull() {
+			detail += " Set scope to pick one."
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Ambiguous Role Name", detail)
+		return
+	}
+
+	permissions, diags := types.SetValueFrom(ctx, types.StringType, matches[0].Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(matches[0].ID)
+	data.Scope = types.StringValue(matches[0].Scope)
+	data.Description = types.StringValue(matches[0].Description)
+	data.Permissions = permissions
+	data.Custom = types.BoolValue(matches[0].Custom)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
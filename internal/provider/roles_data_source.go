@@ -0,0 +1,230 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/role"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &RolesDataSource{}
+
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+// RolesDataSource defines the data source implementation.
+type RolesDataSource struct {
+	client snykclient.Client
+}
+
+// RolesDataSourceModel describes the data source data model.
+type RolesDataSourceModel struct {
+	Id             types.String               `tfsdk:"id"`
+	GroupId        types.String               `tfsdk:"group_id"`
+	OrganizationId types.String               `tfsdk:"organization_id"`
+	Roles          []RolesDataSourceRoleModel `tfsdk:"roles"`
+}
+
+type RolesDataSourceRoleModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Scope       types.String `tfsdk:"scope"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	Custom      types.Bool   `tfsdk:"custom"`
+}
+
+// rolesScopeAttributes returns the group_id and organization_id attributes
+// selecting the roles of the role data sources.
+func rolesScopeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"group_id": schema.StringAttribute{
+			MarkdownDescription: "Snyk Group GUID, to look up the roles of both scopes of the group",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("organization_id")),
+			},
+		},
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "Snyk Organization GUID, to look up the organization roles of its group",
+			Optional:            true,
+		},
+	}
+}
+
+// listRoles returns the roles of the group groupId, or the organization roles
+// of the group of the organization organizationId.
+func listRoles(ctx context.Context, client snykclient.Client, groupId types.String, organizationId types.String) ([]*role.Role, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scope := ""
+	if !organizationId.IsNull() {
+		org, err := client.OrgClient.GetOrganization(ctx, organizationId.ValueString())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to get Organization, got error: %s", err))
+			return nil, diags
+		}
+		if org.GroupId == "" {
+			diags.AddAttributeError(path.Root("organization_id"), "Organization Without Group", fmt.Sprintf("Organization %s does not belong to a group, so it has no roles", organizationId.ValueString()))
+			return nil, diags
+		}
+
+		groupId = types.StringValue(org.GroupId)
+		scope = role.SCOPE_ORG
+	}
+
+	res, err := client.RoleClient.ListRoles(ctx, groupId.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list Roles, got error: %s", err))
+		return nil, diags
+	}
+
+	roles := []*role.Role{}
+	for _, r := range res {
+		if scope == "" || r.Scope == scope {
+			roles = append(roles, r)
+		}
+	}
+
+	return roles, diags
+}
+
+func (d *RolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := rolesScopeAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Same as group_id or organization_id",
+	}
+	attributes["roles"] = schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The roles",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:    true,
+					Description: "Role ID",
+				},
+				"name": schema.StringAttribute{
+					Computed:    true,
+					Description: "Name of the role",
+				},
+				"description": schema.StringAttribute{
+					Computed:    true,
+					Description: "Description of the role",
+				},
+				"scope": schema.StringAttribute{
+					Computed:    true,
+					Description: "Whether the role is given to members of organizations, org, or of the group, group",
+				},
+				"permissions": schema.SetAttribute{
+					Computed:    true,
+					ElementType: types.StringType,
+					Description: "Permissions given by the role",
+				},
+				"custom": schema.BoolAttribute{
+					Computed:    true,
+					Description: "Whether the role is a custom role, rather than a built-in one",
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Provides the built-in and custom [member roles](https://docs.snyk.io/snyk-admin/manage-users-and-permissions/member-roles) of a Snyk group, " +
+			"or the organization roles available to an organization",
+
+		Attributes: attributes,
+	}
+}
+
+func (d *RolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RolesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, diags := listRoles(ctx, d.client, data.GroupId, data.OrganizationId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.GroupId
+	if !data.OrganizationId.IsNull() {
+		data.Id = data.OrganizationId
+	}
+
+	data.Roles = []RolesDataSourceRoleModel{}
+	for _, r := range res {
+		permissions, diags := types.SetValueFrom(ctx, types.StringType, r.Permissions)
+		resp.Diagnostics.Append(diags...)
+
+		data.Roles = append(data.Roles, RolesDataSourceRoleModel{
+			Id:          types.StringValue(r.ID),
+			Name:        types.StringValue(r.Name),
+			Description: types.StringValue(r.Description),
+			Scope:       types.StringValue(r.Scope),
+			Permissions: permissions,
+			Custom:      types.BoolValue(r.Custom),
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
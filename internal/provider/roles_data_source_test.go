@@ -0,0 +1,65 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccRolesDataSource(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleRolesDataSourceConfig(snykOrgId, "Org Admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.snyk_role.test", "id"),
+					resource.TestCheckResourceAttr("data.snyk_role.test", "scope", "org"),
+					resource.TestCheckResourceAttr("data.snyk_role.test", "custom", "false"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.snyk_roles.test", "roles.*", map[string]string{
+						"name":  "Org Admin",
+						"scope": "org",
+					}),
+				),
+			},
+			// Missing role testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleRolesDataSourceConfig(snykOrgId, "Terraform acceptance test missing role"),
+				ExpectError: regexp.MustCompile("Role Not Found"),
+			},
+		},
+	})
+}
+
+func testAccExampleRolesDataSourceConfig(orgId string, name string) string {
+	return fmt.Sprintf(`
+data "snyk_roles" "test" {
+  organization_id = %[1]q
+}
+
+data "snyk_role" "test" {
+  organization_id = %[1]q
+  name = %[2]q
+}`, orgId, name)
+}
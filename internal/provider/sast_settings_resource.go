@@ -0,0 +1,213 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/organization"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &SastSettingsResource{}
+var _ resource.ResourceWithImportState = &SastSettingsResource{}
+
+func NewSastSettingsResource() resource.Resource {
+	return &SastSettingsResource{}
+}
+
+// SastSettingsResource defines the resource implementation.
+type SastSettingsResource struct {
+	client snykclient.Client
+}
+
+// SastSettingsResourceModel describes the resource data model.
+type SastSettingsResourceModel struct {
+	Id                         types.String `tfsdk:"id"`
+	OrganizationId             types.String `tfsdk:"organization_id"`
+	SastEnabled                types.Bool   `tfsdk:"sast_enabled"`
+	PullRequestAnalysisEnabled types.Bool   `tfsdk:"pull_request_analysis_enabled"`
+	AutofixEnabled             types.Bool   `tfsdk:"autofix_enabled"`
+}
+
+func (r *SastSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sast_settings"
+}
+
+func (r *SastSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Enables or disables [Snyk Code](https://docs.snyk.io/scan-with-snyk/snyk-code) for a Snyk organization. " +
+			"It must not be used along with the snyk_code_enabled attribute of snyk_organization_settings. Destroying this resource leaves the settings unchanged. " +
+			"Pull request analysis and autofix are reported but cannot be changed, as the Snyk API only allows enabling or disabling Snyk Code.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as organization_id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sast_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Enable Snyk Code, i.e. static application security testing",
+				Required:            true,
+			},
+			"pull_request_analysis_enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether Snyk Code tests pull requests",
+			},
+			"autofix_enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether Snyk Code suggests fixes",
+			},
+		},
+	}
+}
+
+func (r *SastSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+func (r *SastSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *SastSettingsResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse SastSettings Organization Guid, got error: %s", err))
+		return
+	}
+
+	settings, err := r.client.OrgClient.UpdateSastSettings(ctx, plan.OrganizationId.ValueString(), plan.SastEnabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SastSettings, got error: %s", err))
+		return
+	}
+
+	plan.Id = plan.OrganizationId
+	convertSastSettingsRemoteData2Local(plan, settings)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func convertSastSettingsRemoteData2Local(data *SastSettingsResourceModel, settings *organization.SastSettings) {
+	data.SastEnabled = types.BoolValue(settings.SastEnabled)
+	data.PullRequestAnalysisEnabled = types.BoolValue(settings.SastPullRequestAnalysisEnabled)
+	data.AutofixEnabled = types.BoolValue(settings.AutofixEnabled)
+}
+
+func (r *SastSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SastSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.OrgClient.GetSastSettings(ctx, data.OrganizationId.ValueString())
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get SastSettings, got error: %s", err))
+		return
+	}
+
+	data.Id = data.OrganizationId
+	convertSastSettingsRemoteData2Local(data, settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SastSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan *SastSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.OrgClient.UpdateSastSettings(ctx, plan.OrganizationId.ValueString(), plan.SastEnabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SastSettings, got error: %s", err))
+		return
+	}
+
+	plan.Id = plan.OrganizationId
+	convertSastSettingsRemoteData2Local(plan, settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SastSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Settings cannot be deleted, they are only removed from the state
+}
+
+func (r *SastSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("organization_id"), req, resp)
+}
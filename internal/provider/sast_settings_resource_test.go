@@ -0,0 +1,70 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSastSettings(t *testing.T) {
+	snykGroupId := readEnvVarOrSkip(t, "TEST_SNYK_GROUP_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleSastSettingsResourceConfig(snykGroupId, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("snyk_sast_settings.test", "id", "snyk_organization.test", "id"),
+					resource.TestCheckResourceAttr("snyk_sast_settings.test", "sast_enabled", "true"),
+					resource.TestCheckResourceAttrSet("snyk_sast_settings.test", "autofix_enabled"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_sast_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleSastSettingsResourceConfig(snykGroupId, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_sast_settings.test", "sast_enabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccExampleSastSettingsResourceConfig(groupId string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "snyk_organization" "test" {
+  name = "Test snyk org sast settings"
+  group_id = %[1]q
+}
+
+resource "snyk_sast_settings" "test" {
+  organization_id = snyk_organization.test.id
+  sast_enabled = %[2]t
+}`, groupId, enabled)
+}
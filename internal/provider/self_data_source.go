@@ -0,0 +1,138 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &SelfDataSource{}
+
+func NewSelfDataSource() datasource.DataSource {
+	return &SelfDataSource{}
+}
+
+// SelfDataSource defines the data source implementation.
+type SelfDataSource struct {
+	client snykclient.Client
+}
+
+// SelfDataSourceModel describes the data source data model.
+type SelfDataSourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Type                  types.String `tfsdk:"type"`
+	Name                  types.String `tfsdk:"name"`
+	Username              types.String `tfsdk:"username"`
+	Email                 types.String `tfsdk:"email"`
+	DefaultOrganizationId types.String `tfsdk:"default_organization_id"`
+}
+
+func (d *SelfDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_self"
+}
+
+func (d *SelfDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Provides the user or service account the provider API token belongs to",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Snyk user or service account id",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "One of [user,service_account]",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the user or service account",
+			},
+			"username": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The username of the user. Empty for service accounts.",
+			},
+			"email": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The email of the user. Empty for service accounts.",
+			},
+			"default_organization_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The id of the default organization of the user",
+			},
+		},
+	}
+}
+
+func (d *SelfDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *SelfDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SelfDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The provider already fetched the principal unless it skipped the
+	// validation of its credentials.
+	self := d.client.Self
+	if self == nil {
+		res, err := d.client.UserClient.GetSelf(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Self, got error: %s", err))
+			return
+		}
+		self = res
+	}
+
+	data.Id = types.StringValue(self.ID)
+	data.Type = types.StringValue(self.Type)
+	data.Name = types.StringValue(self.Name)
+	data.Username = types.StringValue(self.Username)
+	data.Email = types.StringValue(self.Email)
+	data.DefaultOrganizationId = types.StringValue(self.DefaultOrgID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
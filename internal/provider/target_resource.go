@@ -0,0 +1,311 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/project"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &TargetResource{}
+var _ resource.ResourceWithImportState = &TargetResource{}
+
+func NewTargetResource() resource.Resource {
+	return &TargetResource{}
+}
+
+// TargetResource defines the resource implementation.
+type TargetResource struct {
+	client snykclient.Client
+}
+
+// TargetResourceModel describes the resource data model.
+type TargetResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	OrganizationId  types.String `tfsdk:"organization_id"`
+	TargetId        types.String `tfsdk:"target_id"`
+	DisplayName     types.String `tfsdk:"display_name"`
+	Url             types.String `tfsdk:"url"`
+	IsPrivate       types.Bool   `tfsdk:"is_private"`
+	IntegrationId   types.String `tfsdk:"integration_id"`
+	SourceType      types.String `tfsdk:"source_type"`
+	DeleteOnDestroy types.Bool   `tfsdk:"delete_on_destroy"`
+}
+
+func (r *TargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_target"
+}
+
+func (r *TargetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages an existing Snyk [target](https://docs.snyk.io/snyk-admin/snyk-projects#target), i.e. the repository or image grouping projects, " +
+			"identified either by its id or by its display name. Destroying this resource deletes the target and all its projects only when delete_on_destroy is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Snyk Target ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the target to manage. Conflicts with display_name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("display_name")),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Display name of the target to manage, e.g. `snyk/goof`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URL of the repository or image",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_private": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the repository or image is private",
+			},
+			"integration_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the integration the target was imported through",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type of the integration the target was imported through, e.g. `github`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delete_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Delete the target and all its projects when the resource is destroyed. Defaults to false.",
+			},
+		},
+	}
+}
+
+func (r *TargetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = *client
+}
+
+func (r *TargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan *TargetResourceModel
+	// Read Terraform plan into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := uuid.Parse(plan.OrganizationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse Target Organization Guid, got error: %s", err))
+		return
+	}
+
+	var target *project.Target
+	if plan.TargetId.IsUnknown() || plan.TargetId.IsNull() {
+		target, err = r.findTarget(ctx, plan)
+	} else {
+		target, err = r.client.ProjectClient.GetTarget(ctx, plan.OrganizationId.ValueString(), plan.TargetId.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Target, got error: %s", err))
+		return
+	}
+
+	convertTargetRemoteData2Local(plan, target)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findTarget returns the only target with the display name of plan.
+func (r *TargetResource) findTarget(ctx context.Context, plan *TargetResourceModel) (*project.Target, error) {
+	targets, err := r.client.ProjectClient.ListTargets(ctx, plan.OrganizationId.ValueString(), project.TargetFilter{
+		DisplayName: plan.DisplayName.ValueString(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The display name filter also matches partial names.
+	matches := []*project.Target{}
+	for _, target := range targets {
+		if target.DisplayName == plan.DisplayName.ValueString() {
+			matches = append(matches, target)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no target is named %s", plan.DisplayName.ValueString())
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d targets are named %s, set target_id to select one", len(matches), plan.DisplayName.ValueString())
+	}
+}
+
+func convertTargetRemoteData2Local(data *TargetResourceModel, target *project.Target) {
+	data.Id = types.StringValue(target.ID)
+	data.TargetId = types.StringValue(target.ID)
+	data.DisplayName = types.StringValue(target.DisplayName)
+	data.Url = types.StringValue(target.URL)
+	data.IsPrivate = types.BoolValue(target.IsPrivate)
+	data.IntegrationId = types.StringValue(target.IntegrationID)
+	data.SourceType = types.StringValue(target.SourceType)
+}
+
+func (r *TargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *TargetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target, err := r.client.ProjectClient.GetTarget(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Target, got error: %s", err))
+		return
+	}
+
+	convertTargetRemoteData2Local(data, target)
+	if data.DeleteOnDestroy.IsNull() {
+		data.DeleteOnDestroy = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only delete_on_destroy can change without replacing the target, and it
+	// is only used by Delete.
+	var plan *TargetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *TargetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !data.DeleteOnDestroy.ValueBool() {
+		return
+	}
+
+	err := r.client.ProjectClient.DeleteTarget(ctx, data.OrganizationId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Target, got error: %s", err))
+		return
+	}
+}
+
+func (r *TargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	organizationId, targetId, found := strings.Cut(req.ID, "/")
+	if !found || organizationId == "" || targetId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier with the format organization_id/target_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), targetId)...)
+}
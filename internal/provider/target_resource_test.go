@@ -0,0 +1,66 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTarget(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+	targetId := readEnvVarOrSkip(t, "TEST_SNYK_TARGET_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleResourceConfigForTarget(snykOrgId, targetId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_target.test", "id", targetId),
+					resource.TestCheckResourceAttr("snyk_target.test", "delete_on_destroy", "false"),
+					resource.TestCheckResourceAttrSet("snyk_target.test", "display_name"),
+					resource.TestCheckResourceAttrPair("data.snyk_targets.test", "targets.0.id", "snyk_target.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_target.test",
+				ImportState:       true,
+				ImportStateId:     snykOrgId + "/" + targetId,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase, and leaves
+			// the target as delete_on_destroy is not set
+		},
+	})
+}
+
+func testAccExampleResourceConfigForTarget(orgId string, targetId string) string {
+	return fmt.Sprintf(`
+resource "snyk_target" "test" {
+  organization_id = %[1]q
+  target_id = %[2]q
+}
+
+data "snyk_targets" "test" {
+  organization_id = snyk_target.test.organization_id
+  url = snyk_target.test.url
+}`, orgId, targetId)
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/project"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/snykclient"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &TargetsDataSource{}
+
+func NewTargetsDataSource() datasource.DataSource {
+	return &TargetsDataSource{}
+}
+
+// TargetsDataSource defines the data source implementation.
+type TargetsDataSource struct {
+	client snykclient.Client
+}
+
+// TargetsDataSourceModel describes the data source data model.
+type TargetsDataSourceModel struct {
+	Id             types.String                   `tfsdk:"id"`
+	OrganizationId types.String                   `tfsdk:"organization_id"`
+	DisplayName    types.String                   `tfsdk:"display_name"`
+	SourceTypes    types.Set                      `tfsdk:"source_types"`
+	IsPrivate      types.Bool                     `tfsdk:"is_private"`
+	Url            types.String                   `tfsdk:"url"`
+	Targets        []TargetsDataSourceTargetModel `tfsdk:"targets"`
+}
+
+type TargetsDataSourceTargetModel struct {
+	Id            types.String `tfsdk:"id"`
+	DisplayName   types.String `tfsdk:"display_name"`
+	Url           types.String `tfsdk:"url"`
+	IsPrivate     types.Bool   `tfsdk:"is_private"`
+	IntegrationId types.String `tfsdk:"integration_id"`
+	SourceType    types.String `tfsdk:"source_type"`
+}
+
+func (d *TargetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_targets"
+}
+
+func (d *TargetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Provides the [targets](https://docs.snyk.io/snyk-admin/snyk-projects#target) of a Snyk organization, optionally filtered",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as organization_id",
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Snyk Organization GUID",
+				Required:            true,
+			},
+			"display_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the targets whose display name contains this string",
+			},
+			"source_types": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return the targets imported through one of these integration types, e.g. `github`",
+			},
+			"is_private": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the private, or the public, targets",
+			},
+			"url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the target with this URL",
+			},
+			"targets": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching targets",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Snyk Target ID",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name of the target",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL of the repository or image",
+						},
+						"is_private": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the repository or image is private",
+						},
+						"integration_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the integration the target was imported through",
+						},
+						"source_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the integration the target was imported through",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TargetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*snykclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *snykclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = *client
+}
+
+func (d *TargetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TargetsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := project.TargetFilter{
+		DisplayName: data.DisplayName.ValueString(),
+		IsPrivate:   boolPointer(data.IsPrivate),
+		URL:         data.Url.ValueString(),
+	}
+	resp.Diagnostics.Append(stringSetElements(ctx, data.SourceTypes, &filter.SourceTypes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.ProjectClient.ListTargets(ctx, data.OrganizationId.ValueString(), filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Targets, got error: %s", err))
+		return
+	}
+
+	data.Id = data.OrganizationId
+	data.Targets = []TargetsDataSourceTargetModel{}
+	for _, target := range res {
+		data.Targets = append(data.Targets, TargetsDataSourceTargetModel{
+			Id:            types.StringValue(target.ID),
+			DisplayName:   types.StringValue(target.DisplayName),
+			Url:           types.StringValue(target.URL),
+			IsPrivate:     types.BoolValue(target.IsPrivate),
+			IntegrationId: types.StringValue(target.IntegrationID),
+			SourceType:    types.StringValue(target.SourceType),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
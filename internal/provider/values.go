@@ -0,0 +1,83 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The helpers below convert Terraform values to the pointers used by the API
+// clients for optional fields. Null and unknown values both map to nil, so
+// that fields which are not configured are left out of the requests.
+
+func boolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	result := value.ValueBool()
+	return &result
+}
+
+func int64Pointer(value types.Int64) *int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	result := value.ValueInt64()
+	return &result
+}
+
+func float64Pointer(value types.Float64) *float64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	result := value.ValueFloat64()
+	return &result
+}
+
+func stringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	result := value.ValueString()
+	return &result
+}
+
+func stringSetPointer(ctx context.Context, value types.Set) (*[]string, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	result := []string{}
+	diags := value.ElementsAs(ctx, &result, false)
+	return &result, diags
+}
+
+func stringSetPointerValue(ctx context.Context, value *[]string) (types.Set, diag.Diagnostics) {
+	if value == nil {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, *value)
+}
+
+// stringSetElements copies the elements of value into target, leaving it
+// unchanged when value is null or unknown.
+func stringSetElements(ctx context.Context, value types.Set, target *[]string) diag.Diagnostics {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ElementsAs(ctx, target, false)
+}
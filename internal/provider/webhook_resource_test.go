@@ -0,0 +1,74 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccWebhook(t *testing.T) {
+	snykOrgId := readEnvVarOrFail(t, "TEST_SNYK_ORG_ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleWebhookResourceConfig(snykOrgId, "https://example.com/snyk"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("snyk_webhook.test", "id"),
+					resource.TestCheckResourceAttr("snyk_webhook.test", "url", "https://example.com/snyk"),
+					resource.TestMatchResourceAttr("snyk_webhook.test", "secret", regexp.MustCompile("^[0-9a-f]{64}$")),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "snyk_webhook.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccWebhookImportStateId,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+			// Replace and Read testing
+			{
+				Config: testAccProviderConfig(t) + "\n" +
+					testAccExampleWebhookResourceConfig(snykOrgId, "https://example.com/snyk/events"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("snyk_webhook.test", "url", "https://example.com/snyk/events"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWebhookImportStateId(s *terraform.State) (string, error) {
+	rs := s.RootModule().Resources["snyk_webhook.test"]
+	return rs.Primary.Attributes["organization_id"] + "/" + rs.Primary.ID, nil
+}
+
+func testAccExampleWebhookResourceConfig(orgId string, url string) string {
+	return fmt.Sprintf(`
+resource "snyk_webhook" "test" {
+  organization_id = %[1]q
+  url = %[2]q
+}`, orgId, url)
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/snykwebhook"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ function.Function = &WebhookSignatureFunction{}
+
+func NewWebhookSignatureFunction() function.Function {
+	return &WebhookSignatureFunction{}
+}
+
+// WebhookSignatureFunction defines the function implementation.
+type WebhookSignatureFunction struct{}
+
+func (f *WebhookSignatureFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "webhook_signature"
+}
+
+func (f *WebhookSignatureFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Signs a webhook event body",
+		MarkdownDescription: "Returns the signature of a webhook event body for the secret of a snyk_webhook, as sent by Snyk in the X-Hub-Signature header: " +
+			"`sha256=` followed by the hex encoded HMAC-SHA256 of the body. Useful to build the fixtures of the tests of webhook receivers.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "secret",
+				MarkdownDescription: "Secret of the webhook",
+			},
+			function.StringParameter{
+				Name:                "body",
+				MarkdownDescription: "Body of the event",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *WebhookSignatureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var secret, body string
+
+	resp.Error = req.Arguments.Get(ctx, &secret, &body)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, snykwebhook.Sign(secret, []byte(body)))
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package role
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// RoleAttributes are the attributes of a custom role set on creation. The
+// scope of a role cannot be changed afterwards.
+type RoleAttributes struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Scope       string   `json:"entity_type,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+type roleRequest struct {
+	Data struct {
+		ID         string         `json:"id,omitempty"`
+		Type       string         `json:"type"`
+		Attributes RoleAttributes `json:"attributes"`
+	} `json:"data"`
+}
+
+// CreateRole creates a custom role in the group groupID.
+func (c *Client) CreateRole(ctx context.Context, groupID string, attributes RoleAttributes) (*Role, error) {
+	var request roleRequest
+	request.Data.Type = "role"
+	request.Data.Attributes = attributes
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/groups/%s/roles", c.URL, groupID))
+
+	var resp roleResponse
+	if err := c.Do(ctx, http.MethodPost, url, "application/vnd.api+json", &body, http.StatusCreated, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data.role(), nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package role
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCreateRole(t *testing.T) {
+	var request map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/groups/group/roles" || r.URL.Query().Get("version") != VERSION {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"role","type":"role","attributes":{"name":"CI","description":"","entity_type":"org","permissions":["org.read"],"custom":true}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{URL: server.URL, Token: "token", Version: VERSION})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	role, err := client.CreateRole(context.Background(), "group", RoleAttributes{
+		Name:        "CI",
+		Scope:       SCOPE_ORG,
+		Permissions: []string{"org.read"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedRole := &Role{ID: "role", Name: "CI", Scope: SCOPE_ORG, Permissions: []string{"org.read"}, Custom: true}
+	if !reflect.DeepEqual(role, expectedRole) {
+		t.Errorf("expected role %v, got %v", expectedRole, role)
+	}
+
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "role",
+			"attributes": map[string]interface{}{
+				"name":        "CI",
+				"description": "",
+				"entity_type": "org",
+				"permissions": []interface{}{"org.read"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(request, expected) {
+		t.Errorf("expected request %v, got %v", expected, request)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package role
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+const (
+	SCOPE_ORG   = "org"
+	SCOPE_GROUP = "group"
+)
+
+// Role is a set of permissions given to the members of an organization or a
+// group, depending on its scope.
+type Role struct {
+	ID          string
+	Name        string
+	Description string
+	Scope       string
+	Permissions []string
+	Custom      bool
+}
+
+type roleData struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		EntityType  string   `json:"entity_type"`
+		Permissions []string `json:"permissions"`
+		Custom      bool     `json:"custom"`
+	} `json:"attributes"`
+}
+
+type roleResponse struct {
+	Data roleData `json:"data"`
+}
+
+func (d *roleData) role() *Role {
+	permissions := d.Attributes.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	return &Role{
+		ID:          d.ID,
+		Name:        d.Attributes.Name,
+		Description: d.Attributes.Description,
+		Scope:       d.Attributes.EntityType,
+		Permissions: permissions,
+		Custom:      d.Attributes.Custom,
+	}
+}
+
+// GetRole returns the role id of the group groupID, or nil if there is no
+// such role.
+func (c *Client) GetRole(ctx context.Context, groupID string, id string) (*Role, error) {
+	var resp roleResponse
+	err := c.GetREST(ctx, fmt.Sprintf("%s/rest/groups/%s/roles/%s", c.URL, groupID, id), &resp)
+	if snyk_http.HasStatusCode(err, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Data.role(), nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package role
+
+import (
+	"context"
+	"fmt"
+
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+)
+
+// ListRoles returns the built-in and custom roles of the group groupID, of
+// both scopes.
+func (c *Client) ListRoles(ctx context.Context, groupID string) ([]*Role, error) {
+	data, err := snyk_http.GetAllPages[roleData](ctx, c.APIClient, fmt.Sprintf("%s/rest/groups/%s/roles?limit=100", c.URL, groupID))
+	if err != nil {
+		return nil, err
+	}
+
+	roles := []*Role{}
+	for i := range data {
+		roles = append(roles, data[i].role())
+	}
+
+	return roles, nil
+}
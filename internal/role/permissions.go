@@ -0,0 +1,107 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package role
+
+// The catalog of the permissions which can be given by custom roles, by
+// scope. It follows the permissions listed in the member roles settings of
+// the Snyk UI.
+
+var ORG_PERMISSIONS = []string{
+	"org.read",
+	"org.edit",
+	"org.report.read",
+	"org.entitlements.read",
+	"org.audit_log.read",
+	"org.billing.read",
+	"org.billing.edit",
+	"org.integration.read",
+	"org.integration.edit",
+	"org.iac.settings.read",
+	"org.iac.settings.edit",
+	"org.package.test",
+	"org.sbom.read",
+	"org.project.read",
+	"org.project.add",
+	"org.project.edit",
+	"org.project.delete",
+	"org.project.move",
+	"org.project.status",
+	"org.project.test",
+	"org.project.attributes.edit",
+	"org.project.tags.edit",
+	"org.project.snapshot.read",
+	"org.project.ignore.read",
+	"org.project.ignore.create",
+	"org.project.ignore.edit",
+	"org.project.ignore.delete",
+	"org.project.jira_issue.read",
+	"org.project.jira_issue.create",
+	"org.project.pr.create",
+	"org.project.pr.skip",
+	"org.collection.read",
+	"org.collection.create",
+	"org.collection.edit",
+	"org.collection.delete",
+	"org.user.read",
+	"org.user.invite",
+	"org.user.add",
+	"org.user.remove",
+	"org.user.role.edit",
+	"org.user.leave",
+	"org.service_account.read",
+	"org.service_account.create",
+	"org.service_account.edit",
+	"org.service_account.delete",
+	"org.webhook.read",
+	"org.webhook.add",
+	"org.webhook.delete",
+}
+
+var GROUP_PERMISSIONS = []string{
+	"group.read",
+	"group.edit",
+	"group.report.read",
+	"group.audit_log.read",
+	"group.settings.edit",
+	"group.settings.sso.read",
+	"group.settings.sso.edit",
+	"group.iac.settings.read",
+	"group.iac.settings.edit",
+	"group.org.create",
+	"group.policy.read",
+	"group.policy.create",
+	"group.policy.edit",
+	"group.policy.delete",
+	"group.tag.edit",
+	"group.role.read",
+	"group.role.create",
+	"group.role.edit",
+	"group.role.delete",
+	"group.user.read",
+	"group.user.edit",
+	"group.service_account.read",
+	"group.service_account.create",
+	"group.service_account.edit",
+	"group.service_account.delete",
+}
+
+// Permissions returns the catalog of the permissions of scope, SCOPE_ORG or
+// SCOPE_GROUP.
+func Permissions(scope string) []string {
+	if scope == SCOPE_GROUP {
+		return GROUP_PERMISSIONS
+	}
+	return ORG_PERMISSIONS
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package role
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// UpdateRole changes the name, description and permissions of the custom
+// role id of the group groupID. The Scope of attributes is ignored.
+func (c *Client) UpdateRole(ctx context.Context, groupID string, id string, attributes RoleAttributes) (*Role, error) {
+	var request roleRequest
+	request.Data.ID = id
+	request.Data.Type = "role"
+	request.Data.Attributes = attributes
+	request.Data.Attributes.Scope = ""
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(request); err != nil {
+		return nil, err
+	}
+
+	url := c.WithVersion(fmt.Sprintf("%s/rest/groups/%s/roles/%s", c.URL, groupID, id))
+
+	var resp roleResponse
+	if err := c.Do(ctx, http.MethodPatch, url, "application/vnd.api+json", &body, http.StatusOK, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Data.role(), nil
+}
@@ -1,24 +1,173 @@
 package snykclient
 
 import (
+	"os"
+
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/broker"
 	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/cloudapi"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/collection"
+	snyk_http "github.com/snyk-terraform-assets/terraform-provider-snyk/internal/http"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/integration"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/membership"
 	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/organization"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/policy"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/project"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/role"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/user"
+	"github.com/snyk-terraform-assets/terraform-provider-snyk/internal/webhook"
 )
 
 type Client struct {
-	CloudapiClient *cloudapi.Client
-	OrgClient      *organization.Client
+	CloudapiClient    *cloudapi.Client
+	OrgClient         *organization.Client
+	UserClient        *user.Client
+	IntegrationClient *integration.Client
+	ProjectClient     *project.Client
+	MembershipClient  *membership.Client
+	RoleClient        *role.Client
+	PolicyClient      *policy.Client
+	WebhookClient     *webhook.Client
+	CollectionClient  *collection.Client
+	BrokerClient      *broker.Client
+
+	// Self is the principal the API token belongs to. It is nil when the
+	// provider skipped the validation of its credentials.
+	Self *user.Self
+}
+
+// Config holds the settings shared by all the API clients. At most one of
+// Token and BearerToken should be set.
+type Config struct {
+	URL         string
+	Token       string
+	BearerToken string
+
+	// HTTPOptions configure the HTTP client, in addition to the certificates
+	// found in NODE_EXTRA_CA_CERTS.
+	HTTPOptions []snyk_http.Option
 }
 
-func NewClient(url string, token string) (*Client, error) {
-	cloudapiClient, err := cloudapi.NewClient(url, token)
+func NewClient(config Config) (*Client, error) {
+	options := append([]snyk_http.Option{
+		snyk_http.WithExtraCertificates(os.Getenv("NODE_EXTRA_CA_CERTS")),
+	}, config.HTTPOptions...)
+
+	httpClient, err := snyk_http.NewClient(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	cloudapiClient, err := cloudapi.NewClient(cloudapi.ClientConfig{
+		HTTPClient:  snyk_http.WithLogging(httpClient, cloudapi.SUBSYSTEM),
+		URL:         config.URL,
+		Token:       config.Token,
+		BearerToken: config.BearerToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	orgClient, err := organization.NewClient(organization.ClientConfig{
+		HTTPClient:  snyk_http.WithLogging(httpClient, organization.SUBSYSTEM),
+		URL:         config.URL,
+		Token:       config.Token,
+		BearerToken: config.BearerToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	userClient, err := user.NewClient(user.ClientConfig{
+		HTTPClient:  snyk_http.WithLogging(httpClient, user.SUBSYSTEM),
+		URL:         config.URL,
+		Token:       config.Token,
+		BearerToken: config.BearerToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	integrationClient, err := integration.NewClient(integration.ClientConfig{
+		HTTPClient:  snyk_http.WithLogging(httpClient, integration.SUBSYSTEM),
+		URL:         config.URL,
+		Token:       config.Token,
+		BearerToken: config.BearerToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	projectClient, err := project.NewClient(project.ClientConfig{
+		HTTPClient:  snyk_http.WithLogging(httpClient, project.SUBSYSTEM),
+		URL:         config.URL,
+		Token:       config.Token,
+		BearerToken: config.BearerToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	membershipClient, err := membership.NewClient(membership.ClientConfig{
+		HTTPClient:  snyk_http.WithLogging(httpClient, membership.SUBSYSTEM),
+		URL:         config.URL,
+		Token:       config.Token,
+		BearerToken: config.BearerToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	roleClient, err := role.NewClient(role.ClientConfig{
+		HTTPClient:  snyk_http.WithLogging(httpClient, role.SUBSYSTEM),
+		URL:         config.URL,
+		Token:       config.Token,
+		BearerToken: config.BearerToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	policyClient, err := policy.NewClient(policy.ClientConfig{
+		HTTPClient:  snyk_http.WithLogging(httpClient, policy.SUBSYSTEM),
+		URL:         config.URL,
+		Token:       config.Token,
+		BearerToken: config.BearerToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	webhookClient, err := webhook.NewClient(webhook.ClientConfig{
+		HTTPClient:  snyk_http.WithLogging(httpClient, webhook.SUBSYSTEM),
+		URL:         config.URL,
+		Token:       config.Token,
+		BearerToken: config.BearerToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	collectionClient, err := collection.NewClient(collection.ClientConfig{
+		HTTPClient:  snyk_http.WithLogging(httpClient, collection.SUBSYSTEM),
+		URL:         config.URL,
+		Token:       config.Token,
+		BearerToken: config.BearerToken,
+	})
 	if err != nil {
 		return nil, err
 	}
-	orgClient, err := organization.NewClient(url, token)
+	brokerClient, err := broker.NewClient(broker.ClientConfig{
+		HTTPClient:  snyk_http.WithLogging(httpClient, broker.SUBSYSTEM),
+		URL:         config.URL,
+		Token:       config.Token,
+		BearerToken: config.BearerToken,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &Client{CloudapiClient: cloudapiClient, OrgClient: orgClient}, nil
+	return &Client{
+		CloudapiClient:    cloudapiClient,
+		OrgClient:         orgClient,
+		UserClient:        userClient,
+		IntegrationClient: integrationClient,
+		ProjectClient:     projectClient,
+		MembershipClient:  membershipClient,
+		RoleClient:        roleClient,
+		PolicyClient:      policyClient,
+		WebhookClient:     webhookClient,
+		CollectionClient:  collectionClient,
+		BrokerClient:      brokerClient,
+	}, nil
 }
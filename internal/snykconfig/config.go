@@ -0,0 +1,149 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package snykconfig reads the credentials stored by the Snyk CLI, so that
+// the provider can reuse the result of a local `snyk auth`.
+package snykconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// KeyAPI is the key under which `snyk auth` stores an API token.
+	KeyAPI = "api"
+	// KeyOAuthTokenStorage is the key under which `snyk auth` stores an
+	// OAuth token, serialized as a JSON string.
+	KeyOAuthTokenStorage = "INTERNAL_OAUTH_TOKEN_STORAGE"
+
+	// envPrefix is the prefix of the environment variables the Snyk CLI uses
+	// to override values of its configuration file.
+	envPrefix = "SNYK_CFG_"
+)
+
+// Credentials are the credentials found in the Snyk CLI configuration. At most
+// one of Token and BearerToken is set. Warning explains why a stored OAuth
+// token was ignored, if it was.
+type Credentials struct {
+	Token       string
+	BearerToken string
+	Warning     string
+}
+
+// Empty returns true if no credentials were found.
+func (c *Credentials) Empty() bool {
+	return c.Token == "" && c.BearerToken == ""
+}
+
+type oauthToken struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Path returns the location of the Snyk CLI configuration file, following
+// the conventions of the configstore library used by the CLI.
+func Path() (string, error) {
+	if dir, ok := os.LookupEnv("XDG_CONFIG_HOME"); ok && dir != "" {
+		return filepath.Join(dir, "configstore", "snyk.json"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("locate home directory: %v", err)
+	}
+
+	return filepath.Join(home, ".config", "configstore", "snyk.json"), nil
+}
+
+// LoadCredentials reads the credentials from the Snyk CLI configuration file
+// and the SNYK_CFG_* environment variables. A missing configuration file is
+// not an error and results in empty credentials, and neither is an invalid or
+// expired OAuth token, which results in empty credentials with a Warning.
+func LoadCredentials() (*Credentials, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	return loadCredentials(path, time.Now())
+}
+
+func loadCredentials(path string, now time.Time) (*Credentials, error) {
+	values, err := readConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// An API token, e.g. set with SNYK_CFG_API, takes precedence over the
+	// OAuth token, which may have expired.
+	if token := lookup(values, KeyAPI); token != "" {
+		return &Credentials{Token: token}, nil
+	}
+
+	raw := lookup(values, KeyOAuthTokenStorage)
+	if raw == "" {
+		return &Credentials{}, nil
+	}
+
+	var token oauthToken
+	if err := json.Unmarshal([]byte(raw), &token); err != nil {
+		return &Credentials{Warning: fmt.Sprintf("unable to parse %s from %s: %v", KeyOAuthTokenStorage, path, err)}, nil
+	}
+	if token.AccessToken == "" {
+		return &Credentials{Warning: fmt.Sprintf("no access token found in %s from %s", KeyOAuthTokenStorage, path)}, nil
+	}
+	if !token.Expiry.IsZero() && !token.Expiry.After(now) {
+		return &Credentials{Warning: fmt.Sprintf("the OAuth token stored by the Snyk CLI expired at %s, run `snyk auth` to refresh it", token.Expiry.Format(time.RFC3339))}, nil
+	}
+	return &Credentials{BearerToken: token.AccessToken}, nil
+}
+
+// lookup returns the value of key, giving precedence to the matching
+// SNYK_CFG_* environment variable over the configuration file.
+func lookup(values map[string]interface{}, key string) string {
+	if env, ok := os.LookupEnv(envPrefix + strings.ToUpper(key)); ok {
+		return env
+	}
+
+	if value, ok := values[key].(string); ok {
+		return value
+	}
+
+	return ""
+}
+
+func readConfig(path string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return values, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read Snyk CLI configuration: %v", err)
+	}
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("parse Snyk CLI configuration %s: %v", path, err)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snykconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "snyk.json")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadCredentialsMissingFile(t *testing.T) {
+	creds, err := loadCredentials(filepath.Join(t.TempDir(), "snyk.json"), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !creds.Empty() {
+		t.Fatalf("expected empty credentials, got %+v", creds)
+	}
+}
+
+func TestLoadCredentialsAPIToken(t *testing.T) {
+	path := writeConfig(t, `{"api": "my-token", "org": "my-org"}`)
+
+	creds, err := loadCredentials(path, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Token != "my-token" || creds.BearerToken != "" {
+		t.Fatalf("unexpected credentials %+v", creds)
+	}
+}
+
+func TestLoadCredentialsOAuthToken(t *testing.T) {
+	path := writeConfig(t, `{"INTERNAL_OAUTH_TOKEN_STORAGE": "{\"access_token\":\"access\",\"token_type\":\"Bearer\",\"refresh_token\":\"refresh\",\"expiry\":\"2023-06-01T10:00:00Z\"}"}`)
+
+	creds, err := loadCredentials(path, time.Date(2023, 6, 1, 9, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.BearerToken != "access" || creds.Token != "" {
+		t.Fatalf("unexpected credentials %+v", creds)
+	}
+
+	creds, err = loadCredentials(path, time.Date(2023, 6, 1, 11, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !creds.Empty() || creds.Warning == "" {
+		t.Fatalf("expected empty credentials with a warning for an expired OAuth token, got %+v", creds)
+	}
+}
+
+func TestLoadCredentialsAPITokenBeforeExpiredOAuthToken(t *testing.T) {
+	path := writeConfig(t, `{"INTERNAL_OAUTH_TOKEN_STORAGE": "{\"access_token\":\"access\",\"expiry\":\"2023-06-01T10:00:00Z\"}"}`)
+	t.Setenv("SNYK_CFG_API", "env-token")
+
+	creds, err := loadCredentials(path, time.Date(2023, 6, 1, 11, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Token != "env-token" || creds.BearerToken != "" || creds.Warning != "" {
+		t.Fatalf("expected token from SNYK_CFG_API, got %+v", creds)
+	}
+}
+
+func TestLoadCredentialsEnvironmentOverride(t *testing.T) {
+	path := writeConfig(t, `{"api": "file-token"}`)
+	t.Setenv("SNYK_CFG_API", "env-token")
+
+	creds, err := loadCredentials(path, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Token != "env-token" {
+		t.Fatalf("expected token from SNYK_CFG_API, got %+v", creds)
+	}
+}
+
+func TestLoadCredentialsInvalidFile(t *testing.T) {
+	path := writeConfig(t, `not json`)
+
+	if _, err := loadCredentials(path, time.Now()); err == nil {
+		t.Fatal("expected an error for an invalid configuration file")
+	}
+}
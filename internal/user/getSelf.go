@@ -0,0 +1,62 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"fmt"
+)
+
+const TYPE_USER = "user"
+const TYPE_SERVICE_ACCOUNT = "service_account"
+
+type SelfResponse struct {
+	Data struct {
+		Type       string `json:"type"`
+		ID         string `json:"id"`
+		Attributes struct {
+			Name              string `json:"name"`
+			Username          string `json:"username"`
+			Email             string `json:"email"`
+			DefaultOrgContext string `json:"default_org_context"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+// Self is the user or service account the API token belongs to.
+type Self struct {
+	ID           string
+	Type         string
+	Name         string
+	Username     string
+	Email        string
+	DefaultOrgID string
+}
+
+func (c *Client) GetSelf(ctx context.Context) (*Self, error) {
+	var result SelfResponse
+	if err := c.GetREST(ctx, fmt.Sprintf("%s/rest/self", c.URL), &result); err != nil {
+		return nil, err
+	}
+
+	return &Self{
+		ID:           result.Data.ID,
+		Type:         result.Data.Type,
+		Name:         result.Data.Attributes.Name,
+		Username:     result.Data.Attributes.Username,
+		Email:        result.Data.Attributes.Email,
+		DefaultOrgID: result.Data.Attributes.DefaultOrgContext,
+	}, nil
+}
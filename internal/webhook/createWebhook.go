@@ -0,0 +1,50 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type webhookRequest struct {
+	URL    string `json:"url"`
+	Secret string `json:"secret"`
+}
+
+// CreateWebhook creates a webhook of the organization orgID, sending its
+// events to url, signed with secret.
+func (c *Client) CreateWebhook(ctx context.Context, orgID string, url string, secret string) (*Webhook, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(webhookRequest{URL: url, Secret: secret}); err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	if err := c.Do(ctx, http.MethodPost, fmt.Sprintf("%s/v1/org/%s/webhooks", c.URL, orgID), "application/json", &body, http.StatusOK, &webhook); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// PingWebhook sends a ping event to the webhook id of the organization orgID.
+// It fails if the event is not delivered.
+func (c *Client) PingWebhook(ctx context.Context, orgID string, id string) error {
+	return c.Do(ctx, http.MethodPost, fmt.Sprintf("%s/v1/org/%s/webhooks/%s/ping", c.URL, orgID, id), "application/json", nil, http.StatusOK, nil)
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+)
+
+// Webhook sends the events of an organization to URL. Its secret is never
+// returned by the API.
+type Webhook struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+type webhooksResponse struct {
+	Results []Webhook `json:"results"`
+}
+
+// ListWebhooks returns the webhooks of the organization orgID.
+func (c *Client) ListWebhooks(ctx context.Context, orgID string) ([]Webhook, error) {
+	var resp webhooksResponse
+	if err := c.Get(ctx, fmt.Sprintf("%s/v1/org/%s/webhooks", c.URL, orgID), &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.Results == nil {
+		return []Webhook{}, nil
+	}
+	return resp.Results, nil
+}
+
+// GetWebhook returns the webhook id of the organization orgID, or nil if there
+// is no such webhook.
+func (c *Client) GetWebhook(ctx context.Context, orgID string, id string) (*Webhook, error) {
+	webhooks, err := c.ListWebhooks(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range webhooks {
+		if webhooks[i].ID == id {
+			return &webhooks[i], nil
+		}
+	}
+
+	return nil, nil
+}
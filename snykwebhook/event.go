@@ -0,0 +1,103 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snykwebhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The types of events, as sent in the X-Snyk-Event header.
+const (
+	EVENT_PING             = "ping"
+	EVENT_PROJECT_SNAPSHOT = "project_snapshot"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrUnknownEvent     = errors.New("unknown webhook event")
+)
+
+// PingEvent is sent when a webhook is created, and on demand.
+type PingEvent struct {
+	WebhookID string `json:"webhookId"`
+}
+
+// ProjectSnapshotEvent is sent when a project is tested, with the issues
+// found since the previous test.
+type ProjectSnapshotEvent struct {
+	Project       Project `json:"project"`
+	Org           Org     `json:"org"`
+	Group         *Group  `json:"group,omitempty"`
+	NewIssues     []Issue `json:"newIssues"`
+	RemovedIssues []Issue `json:"removedIssues"`
+}
+
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type Org struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Group struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Issue struct {
+	ID          string    `json:"id"`
+	IssueType   string    `json:"issueType"`
+	PkgName     string    `json:"pkgName"`
+	PkgVersions []string  `json:"pkgVersions"`
+	IssueData   IssueData `json:"issueData"`
+}
+
+type IssueData struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Severity string `json:"severity"`
+	URL      string `json:"url"`
+}
+
+// ParseEvent parses the payload of an event of type eventType, the value of
+// the X-Snyk-Event header. Versioned types, such as project_snapshot/v0, are
+// parsed as their unversioned type. It returns a *PingEvent or a
+// *ProjectSnapshotEvent, or ErrUnknownEvent for other types.
+func ParseEvent(eventType string, body []byte) (interface{}, error) {
+	name, _, _ := strings.Cut(eventType, "/")
+
+	var event interface{}
+	switch name {
+	case EVENT_PING:
+		event = &PingEvent{}
+	case EVENT_PROJECT_SNAPSHOT:
+		event = &ProjectSnapshotEvent{}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEvent, eventType)
+	}
+
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package snykwebhook computes and verifies the signatures of the events sent
+// by Snyk webhooks, and parses their payloads. It lets the receivers of the
+// webhooks managed by snyk_webhook resources check that the events come from
+// Snyk.
+package snykwebhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+)
+
+const (
+	// SIGNATURE_HEADER is the header holding the signature of the events.
+	SIGNATURE_HEADER = "X-Hub-Signature"
+	// EVENT_HEADER is the header holding the type of the events.
+	EVENT_HEADER = "X-Snyk-Event"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the signature of body for the secret of a webhook, in the
+// format of the X-Hub-Signature header: sha256=<hex encoded HMAC-SHA256>.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify returns whether signature is the signature of body for the secret
+// of a webhook. The comparison takes a constant time.
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// ReadRequest reads the body of an event request and verifies its signature
+// for the secret of a webhook. It returns ErrInvalidSignature if the
+// signature is missing or does not match.
+func ReadRequest(secret string, r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !Verify(secret, body, r.Header.Get(SIGNATURE_HEADER)) {
+		return nil, ErrInvalidSignature
+	}
+
+	return body, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 Snyk Limited All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snykwebhook
+
+import (
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSign(t *testing.T) {
+	// echo -n '{"webhookId":"1"}' | openssl dgst -sha256 -hmac secret
+	expected := "sha256=f68656bf79b46da61347f484e382b5be8a37ccbeb8c96ad1864b1b2446e9b119"
+	if got := Sign("secret", []byte(`{"webhookId":"1"}`)); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestReadRequest(t *testing.T) {
+	body := `{"webhookId":"1"}`
+
+	req := httptest.NewRequest("POST", "/snyk", strings.NewReader(body))
+	req.Header.Set(SIGNATURE_HEADER, Sign("secret", []byte(body)))
+	if got, err := ReadRequest("secret", req); err != nil || string(got) != body {
+		t.Errorf("expected the body %s, got %s and error %v", body, got, err)
+	}
+
+	req = httptest.NewRequest("POST", "/snyk", strings.NewReader(body))
+	req.Header.Set(SIGNATURE_HEADER, Sign("other secret", []byte(body)))
+	if _, err := ReadRequest("secret", req); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	event, err := ParseEvent("project_snapshot/v0", []byte(`{"project":{"id":"p","name":"goof"},"org":{"id":"o"},
+		"newIssues":[{"id":"SNYK-JS-LODASH-1","issueData":{"severity":"high"}}],"removedIssues":[]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &ProjectSnapshotEvent{
+		Project:       Project{ID: "p", Name: "goof"},
+		Org:           Org{ID: "o"},
+		NewIssues:     []Issue{{ID: "SNYK-JS-LODASH-1", IssueData: IssueData{Severity: "high"}}},
+		RemovedIssues: []Issue{},
+	}
+	if !reflect.DeepEqual(event, expected) {
+		t.Errorf("expected %+v, got %+v", expected, event)
+	}
+
+	if _, err := ParseEvent("other", []byte(`{}`)); !errors.Is(err, ErrUnknownEvent) {
+		t.Errorf("expected ErrUnknownEvent, got %v", err)
+	}
+}